@@ -0,0 +1,311 @@
+package tz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"maps"
+	"math"
+	"slices"
+	"time"
+	"unsafe"
+)
+
+// Binary dataset format.
+//
+// The binary format is a compact, versioned and checksummed encoding of a
+// registry intended for runtime reloading and for sharing the dataset with
+// services written in other languages. All integers are LEB128 varints
+// (signed values zig-zag encoded, as in encoding/binary); strings are a
+// varint byte length followed by UTF-8 bytes.
+//
+//	magic      4 bytes  "TZDB"
+//	format     1 byte   binaryFormatVersion
+//	version    string   dataset version
+//	zones      uvarint count, then per zone sorted by identifier:
+//	             identifier string
+//	             country    string  ISO 3166-1 alpha-2 code or empty
+//	             offset     varint  standard UTC offset in minutes east of UTC
+//	             rule       string  POSIX TZ rule or empty
+//	             history    uvarint count, then per past offset change,
+//	                        oldest first (format version 2 and later):
+//	                          at      varint  seconds since the previous
+//	                                          change, or since the Unix epoch
+//	                                          for the first
+//	                          offset  varint  UTC offset in seconds east of UTC
+//	                          dst     uvarint 1 if daylight saving time, else 0
+//	                          abbr    string  abbreviation, e.g. "CEST"
+//	links      uvarint count, then per link sorted by alias:
+//	             alias      string
+//	             target     string
+//	countries  uvarint count, then per country sorted by alpha-2 code:
+//	             alpha2     string
+//	             alpha3     string
+//	             numeric    string
+//	             name       string  English short name
+//	checksum   4 bytes  big-endian CRC-32 (IEEE) of all preceding bytes
+//
+// Format version 1 is identical except that zones have no history. Decoders
+// accept both versions; encoders write the current one.
+const (
+	binaryMagic         = "TZDB"
+	binaryFormatVersion = 2
+)
+
+// MarshalBinary encodes the registry in the binary dataset format, including
+// metadata for every country referenced by its zones.
+// It implements encoding.BinaryMarshaler.
+func (r *Registry) MarshalBinary() ([]byte, error) {
+	buf := append([]byte(binaryMagic), binaryFormatVersion)
+	buf = appendString(buf, r.Version())
+
+	ids := r.All()
+	codes := make(map[string]bool)
+
+	buf = binary.AppendUvarint(buf, uint64(len(ids)))
+
+	for _, id := range ids {
+		data := r.zones[id]
+
+		buf = appendString(buf, id)
+		buf = appendString(buf, data.countryCode)
+		buf = binary.AppendVarint(buf, int64(math.Round(float64(data.utcOffset)*60)))
+		buf = appendString(buf, r.rules[id])
+
+		history := r.history[id]
+		buf = binary.AppendUvarint(buf, uint64(len(history)))
+
+		var prev int64
+
+		for _, t := range history {
+			dst := uint64(0)
+			if t.DST {
+				dst = 1
+			}
+
+			buf = binary.AppendVarint(buf, t.At.Unix()-prev)
+			buf = binary.AppendVarint(buf, int64(math.Round(float64(t.Offset)*3600)))
+			buf = binary.AppendUvarint(buf, dst)
+			buf = appendString(buf, t.Abbreviation)
+			prev = t.At.Unix()
+		}
+
+		if _, ok := countries[data.countryCode]; ok {
+			codes[data.countryCode] = true
+		}
+	}
+
+	names := slices.Sorted(maps.Keys(r.links))
+	buf = binary.AppendUvarint(buf, uint64(len(names)))
+
+	for _, name := range names {
+		buf = appendString(buf, name)
+		buf = appendString(buf, r.links[name])
+	}
+
+	buf = binary.AppendUvarint(buf, uint64(len(codes)))
+
+	for _, code := range slices.Sorted(maps.Keys(codes)) {
+		data := countries[code]
+
+		buf = appendString(buf, code)
+		buf = appendString(buf, data.alpha3)
+		buf = appendString(buf, data.numeric)
+		buf = appendString(buf, data.name)
+	}
+
+	return binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf)), nil
+}
+
+func appendString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+
+	return append(buf, s...)
+}
+
+// DecodeBinary returns a registry decoded from the binary dataset format.
+// Decoding is zero-copy: identifiers, rules and other strings of the
+// registry share memory with data, which must not be modified afterwards.
+// Country metadata is validated but not used; Go registries always use the
+// package's built-in country metadata.
+// Returns ErrInvalidZone (wrapped) if the data is truncated, corrupted or of
+// an unsupported format version.
+func DecodeBinary(data []byte) (*Registry, error) {
+	const headerLen = len(binaryMagic) + 1
+
+	if len(data) < headerLen+4 || !bytes.HasPrefix(data, []byte(binaryMagic)) {
+		return nil, fmt.Errorf("binary dataset: missing header: %w", ErrInvalidZone)
+	}
+
+	format := data[len(binaryMagic)]
+	if format < 1 || format > binaryFormatVersion {
+		return nil, fmt.Errorf("binary dataset: unsupported format version %d: %w", data[len(binaryMagic)], ErrInvalidZone)
+	}
+
+	body, sum := data[:len(data)-4], binary.BigEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(body) != sum {
+		return nil, fmt.Errorf("binary dataset: checksum mismatch: %w", ErrInvalidZone)
+	}
+
+	// The strings of the registry point into data rather than into a copy.
+	d := binaryDecoder{s: unsafe.String(unsafe.SliceData(body), len(body))[headerLen:]} //nolint:gosec // data is documented to be immutable after decoding.
+
+	version := d.str()
+
+	zones := make([]ZoneData, d.count())
+	for i := range zones {
+		zones[i] = ZoneData{
+			Identifier:  d.str(),
+			CountryCode: d.str(),
+			UtcOffset:   float32(d.varint()) / 60,
+			Rule:        d.str(),
+		}
+
+		if format >= 2 {
+			zones[i].History = d.history()
+		}
+	}
+
+	links := make([]LinkData, d.count())
+	for i := range links {
+		links[i] = LinkData{Name: d.str(), Target: d.str()}
+	}
+
+	for range d.count() {
+		if alpha2, alpha3, numeric := d.str(), d.str(), d.str(); len(alpha2) != 2 || len(alpha3) != 3 || len(numeric) != 3 {
+			d.fail()
+		}
+
+		d.str()
+	}
+
+	if d.err == nil && d.s != "" {
+		d.fail()
+	}
+
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	reg, err := NewRegistry(zones)
+	if err != nil {
+		return nil, err
+	}
+
+	reg, err = reg.WithLinks(links...)
+	if err != nil {
+		return nil, err
+	}
+
+	reg.version = version
+
+	return reg, nil
+}
+
+// binaryDecoder reads varints and strings from the body of a binary dataset,
+// recording the first error.
+type binaryDecoder struct {
+	s   string
+	err error
+}
+
+func (d *binaryDecoder) fail() {
+	if d.err == nil {
+		d.err = fmt.Errorf("binary dataset: malformed body: %w", ErrInvalidZone)
+	}
+
+	d.s = ""
+}
+
+func (d *binaryDecoder) uvarint() uint64 {
+	var v uint64
+
+	for i := 0; i < len(d.s) && i < binary.MaxVarintLen64; i++ {
+		b := d.s[i]
+		v |= uint64(b&0x7f) << (7 * i)
+
+		if b < 0x80 {
+			d.s = d.s[i+1:]
+
+			return v
+		}
+	}
+
+	d.fail()
+
+	return 0
+}
+
+func (d *binaryDecoder) varint() int64 {
+	ux := d.uvarint()
+
+	// Undo zig-zag encoding.
+	x := int64(ux >> 1) //nolint:gosec // Shifted value always fits.
+	if ux&1 != 0 {
+		x = ^x
+	}
+
+	return x
+}
+
+// count reads an element count, bounded by the remaining input so that
+// corrupted counts cannot trigger huge allocations.
+func (d *binaryDecoder) count() int {
+	n := d.uvarint()
+	if n > uint64(len(d.s)) {
+		d.fail()
+
+		return 0
+	}
+
+	return int(n)
+}
+
+// history reads the past offset changes of a zone.
+func (d *binaryDecoder) history() []Transition {
+	n := d.count()
+	if n == 0 {
+		return nil
+	}
+
+	result := make([]Transition, n)
+
+	var at int64
+
+	for i := range result {
+		at += d.varint()
+		offset := d.varint()
+		dst := d.uvarint()
+		abbr := d.str()
+
+		if dst > 1 || offset <= -24*3600 || offset >= 24*3600 {
+			d.fail()
+
+			return nil
+		}
+
+		result[i] = Transition{
+			At:           time.Unix(at, 0).In(time.FixedZone(abbr, int(offset))),
+			Offset:       float32(offset) / 3600,
+			Abbreviation: abbr,
+			DST:          dst == 1,
+		}
+	}
+
+	return result
+}
+
+func (d *binaryDecoder) str() string {
+	n := d.uvarint()
+	if n > uint64(len(d.s)) {
+		d.fail()
+
+		return ""
+	}
+
+	s := d.s[:n]
+	d.s = d.s[n:]
+
+	return s
+}
@@ -0,0 +1,283 @@
+package tz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"testing"
+	"unsafe"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data, err := Embedded().MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	r, err := DecodeBinary(data)
+	if err != nil {
+		t.Fatalf("DecodeBinary() error: %v", err)
+	}
+
+	if r.Version() != Embedded().Version() {
+		t.Errorf("Version() = %q, want %q", r.Version(), Embedded().Version())
+	}
+
+	// Re-encoding the decoded registry must reproduce the input exactly.
+	again, err := r.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	if !bytes.Equal(data, again) {
+		t.Error("re-encoded dataset differs from the original")
+	}
+
+	for id, data := range timezones {
+		tz, err := r.Decode(id)
+		if err != nil {
+			t.Errorf("%s: Decode() error: %v", id, err)
+
+			continue
+		}
+
+		if tz.CountryCode() != data.countryCode || tz.UtcOffset() != data.utcOffset || r.rules[id] != rules[id] {
+			t.Errorf("%s: round trip = %s UTC%+g %q", id, tz.CountryCode(), tz.UtcOffset(), r.rules[id])
+		}
+	}
+
+	for name, target := range links {
+		if got, ok := r.Canonical(name); !ok || got != target {
+			t.Errorf("Canonical(%q) = %q, %v, want %q", name, got, ok, target)
+		}
+	}
+}
+
+func TestBinaryRoundTripMinuteOffsets(t *testing.T) {
+	t.Parallel()
+
+	// Offsets such as -8h20m are not exact in float32 and must not be
+	// truncated to the minute below.
+	var zones []ZoneData
+
+	for _, minutes := range []int{-500, -230, 320, 805} {
+		zones = append(zones, ZoneData{Identifier: fmt.Sprintf("Company/M%d", minutes), UtcOffset: float32(minutes) / 60})
+	}
+
+	r, err := NewRegistry(zones)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := r.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	decoded, err := DecodeBinary(data)
+	if err != nil {
+		t.Fatalf("DecodeBinary() error: %v", err)
+	}
+
+	for _, zone := range zones {
+		if tz, err := decoded.Decode(zone.Identifier); err != nil || tz.UtcOffset() != zone.UtcOffset {
+			t.Errorf("%s: round trip UtcOffset() = %v (%v), want %v", zone.Identifier, tz.UtcOffset(), err, zone.UtcOffset)
+		}
+	}
+}
+
+func TestBinaryHistoryRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	const dir = "/usr/share/zoneinfo"
+
+	if _, err := os.Stat(dir + "/zone.tab"); err != nil {
+		t.Skipf("system zoneinfo not available: %v", err)
+	}
+
+	r, err := LoadDir(dir)
+	if err != nil {
+		t.Fatalf("LoadDir() error: %v", err)
+	}
+
+	data, err := r.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	decoded, err := DecodeBinary(data)
+	if err != nil {
+		t.Fatalf("DecodeBinary() error: %v", err)
+	}
+
+	again, err := decoded.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	if !bytes.Equal(data, again) {
+		t.Error("re-encoded dataset differs from the original")
+	}
+
+	want, got := r.History("Europe/Berlin"), decoded.History("Europe/Berlin")
+	if len(want) == 0 || len(got) != len(want) {
+		t.Fatalf("History(Europe/Berlin) has %d entries, want %d", len(got), len(want))
+	}
+
+	for i := range want {
+		if !got[i].At.Equal(want[i].At) || got[i].Offset != want[i].Offset || got[i].Abbreviation != want[i].Abbreviation || got[i].DST != want[i].DST {
+			t.Errorf("History(Europe/Berlin)[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	// Double summer time in 1945.
+	found := false
+
+	for _, tr := range got {
+		found = found || tr.Abbreviation == "CEMT" && tr.Offset == 3 && tr.At.Year() == 1945
+	}
+
+	if !found {
+		t.Error("History(Europe/Berlin) lacks the 1945 CEMT transition")
+	}
+}
+
+func TestDecodeBinaryZeroCopy(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewRegistry([]ZoneData{{Identifier: "Company/HQ", CountryCode: "NO", UtcOffset: 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := r.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	decoded, err := DecodeBinary(data)
+	if err != nil {
+		t.Fatalf("DecodeBinary() error: %v", err)
+	}
+
+	id := decoded.All()[0]
+	start := uintptr(unsafe.Pointer(unsafe.SliceData(data)))
+	p := uintptr(unsafe.Pointer(unsafe.StringData(id)))
+
+	if p < start || p >= start+uintptr(len(data)) {
+		t.Error("decoded identifier does not share memory with the input")
+	}
+}
+
+func TestDecodeBinaryVersion1(t *testing.T) {
+	t.Parallel()
+
+	// A format version 1 dataset has no zone history.
+	buf := append([]byte(binaryMagic), 1)
+	buf = appendString(buf, "2024a")
+	buf = binary.AppendUvarint(buf, 1)
+	buf = appendString(buf, "Company/HQ")
+	buf = appendString(buf, "NO")
+	buf = binary.AppendVarint(buf, 60)
+	buf = appendString(buf, "CET-1CEST,M3.5.0,M10.5.0/3")
+	buf = binary.AppendUvarint(buf, 0)
+	buf = binary.AppendUvarint(buf, 0)
+	buf = binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf))
+
+	r, err := DecodeBinary(buf)
+	if err != nil {
+		t.Fatalf("DecodeBinary() error: %v", err)
+	}
+
+	zone, err := r.Decode("Company/HQ")
+	if err != nil || zone.UtcOffset() != 1 || zone.Rule() != "CET-1CEST,M3.5.0,M10.5.0/3" || r.Version() != "2024a" {
+		t.Errorf("DecodeBinary() = %+v, %v", zone, err)
+	}
+}
+
+func TestDecodeBinaryInvalid(t *testing.T) {
+	t.Parallel()
+
+	valid, err := Embedded().MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	corrupt := func(i int) []byte {
+		data := bytes.Clone(valid)
+		data[i] ^= 0xff
+
+		return data
+	}
+
+	badVersion := bytes.Clone(valid)
+	badVersion[len(binaryMagic)] = 99
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "bad magic", data: corrupt(0)},
+		{name: "unsupported version", data: badVersion},
+		{name: "corrupted body", data: corrupt(len(valid) / 2)},
+		{name: "corrupted checksum", data: corrupt(len(valid) - 1)},
+		{name: "truncated", data: valid[:len(valid)/2]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := DecodeBinary(tt.data); !errors.Is(err, ErrInvalidZone) {
+				t.Errorf("DecodeBinary() error = %v, want ErrInvalidZone", err)
+			}
+		})
+	}
+}
+
+func TestLoadFileBinary(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewRegistry([]ZoneData{{Identifier: "Company/HQ", CountryCode: "NO", UtcOffset: 1, Rule: "CET-1CEST,M3.5.0,M10.5.0/3"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := r.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "zones.tzdb")
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error: %v", err)
+	}
+
+	if !loaded.IsValid("Company/HQ") || loaded.rules["Company/HQ"] != "CET-1CEST,M3.5.0,M10.5.0/3" {
+		t.Error("LoadFile() did not decode the binary dataset")
+	}
+}
+
+func BenchmarkDecodeBinary(b *testing.B) {
+	data, err := Embedded().MarshalBinary()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for b.Loop() {
+		_, _ = DecodeBinary(data)
+	}
+}
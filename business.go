@@ -0,0 +1,58 @@
+package tz
+
+import "time"
+
+// AddBusinessDuration returns the instant at which d of working time has
+// elapsed after start, counting only working hours on working days in the
+// zone's wall-clock time. This is the due time of an SLA measured in business
+// hours. The result is in start's location; d <= 0 returns start unchanged.
+// Returns the zero Time if no weekday is worked.
+func (w WorkingHours) AddBusinessDuration(start time.Time, d time.Duration) time.Time {
+	if d <= 0 {
+		return start
+	}
+
+	if !w.worksAnyDay() {
+		return time.Time{}
+	}
+
+	// Walk forward a week at a time; every week contains working hours
+	// unless it is covered by holidays.
+	for from := start; ; from = from.AddDate(0, 0, 7) {
+		for _, interval := range w.intervals(from, from.AddDate(0, 0, 7)) {
+			if d <= interval.Duration() {
+				return interval.Start.Add(d).In(start.Location())
+			}
+
+			d -= interval.Duration()
+		}
+	}
+}
+
+// BusinessDurationBetween returns the working time elapsed between a and b,
+// counting only working hours on working days in the zone's wall-clock time.
+// The result is negative if b is before a.
+func (w WorkingHours) BusinessDurationBetween(a, b time.Time) time.Duration {
+	if b.Before(a) {
+		return -w.BusinessDurationBetween(b, a)
+	}
+
+	var total time.Duration
+
+	for _, interval := range w.intervals(a, b) {
+		total += interval.Duration()
+	}
+
+	return total
+}
+
+// worksAnyDay reports whether at least one weekday is worked.
+func (w WorkingHours) worksAnyDay() bool {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if !w.dayOff(day) {
+			return true
+		}
+	}
+
+	return false
+}
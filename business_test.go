@@ -0,0 +1,132 @@
+package tz
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestAddBusinessDuration(t *testing.T) {
+	t.Parallel()
+
+	zone := func(id string) Timezone {
+		z, err := Decode(id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		return z
+	}
+
+	berlin := WorkingHours{Zone: zone("Europe/Berlin"), Start: 9 * time.Hour, End: 17 * time.Hour}
+	newYork := WorkingHours{
+		Zone: zone("America/New_York"), Start: 9 * time.Hour, End: 17 * time.Hour,
+		Holidays: []time.Time{time.Date(2026, time.December, 25, 0, 0, 0, 0, time.UTC)},
+	}
+	riyadh := WorkingHours{Zone: zone("Asia/Riyadh"), Start: 9 * time.Hour, End: 17 * time.Hour}
+	auckland := WorkingHours{Zone: zone("Pacific/Auckland"), Start: 9 * time.Hour, End: 17 * time.Hour}
+	never := WorkingHours{
+		Zone: berlin.Zone, Start: 9 * time.Hour, End: 17 * time.Hour,
+		DaysOff: []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday},
+	}
+
+	tests := []struct {
+		name  string
+		hours WorkingHours
+		start time.Time
+		d     time.Duration
+		want  time.Time
+	}{
+		{"same day", berlin, berlin.Zone.Date(2026, time.March, 23, 10, 0, 0, 0), 4 * time.Hour, berlin.Zone.Date(2026, time.March, 23, 14, 0, 0, 0)},
+		{"ends at close", berlin, berlin.Zone.Date(2026, time.March, 27, 16, 0, 0, 0), time.Hour, berlin.Zone.Date(2026, time.March, 27, 17, 0, 0, 0)},
+		{"over weekend and DST", berlin, berlin.Zone.Date(2026, time.March, 27, 16, 0, 0, 0), 2 * time.Hour, berlin.Zone.Date(2026, time.March, 30, 10, 0, 0, 0)},
+		{"starts on weekend", berlin, berlin.Zone.Date(2026, time.March, 28, 12, 0, 0, 0), time.Hour, berlin.Zone.Date(2026, time.March, 30, 10, 0, 0, 0)},
+		{"before opening", berlin, berlin.Zone.Date(2026, time.March, 23, 6, 0, 0, 0), 8 * time.Hour, berlin.Zone.Date(2026, time.March, 23, 17, 0, 0, 0)},
+		{"over holiday", newYork, newYork.Zone.Date(2026, time.December, 24, 16, 0, 0, 0), 2 * time.Hour, newYork.Zone.Date(2026, time.December, 28, 10, 0, 0, 0)},
+		{"country weekend", riyadh, riyadh.Zone.Date(2026, time.July, 2, 16, 0, 0, 0), 2 * time.Hour, riyadh.Zone.Date(2026, time.July, 5, 10, 0, 0, 0)},
+		{"east of UTC", auckland, auckland.Zone.Date(2026, time.March, 23, 10, 0, 0, 0), 41 * time.Hour, auckland.Zone.Date(2026, time.March, 30, 11, 0, 0, 0)},
+		{"east of UTC over DST end", auckland, auckland.Zone.Date(2026, time.April, 3, 16, 0, 0, 0), 2 * time.Hour, auckland.Zone.Date(2026, time.April, 6, 10, 0, 0, 0)},
+		{"zero duration", berlin, berlin.Zone.Date(2026, time.March, 28, 12, 0, 0, 0), 0, berlin.Zone.Date(2026, time.March, 28, 12, 0, 0, 0)},
+		{"no working days", never, berlin.Zone.Date(2026, time.March, 23, 10, 0, 0, 0), time.Hour, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.hours.AddBusinessDuration(tt.start, tt.d)
+			if !got.Equal(tt.want) {
+				t.Errorf("AddBusinessDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusinessDurationBetween(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := Decode("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nineToFive := WorkingHours{Zone: berlin, Start: 9 * time.Hour, End: 17 * time.Hour}
+	withHoliday := nineToFive
+	withHoliday.Holidays = []time.Time{time.Date(2026, time.March, 25, 0, 0, 0, 0, time.UTC)}
+	allDay := WorkingHours{Zone: berlin, Start: 0, End: 24 * time.Hour, DaysOff: []time.Weekday{}}
+
+	auckland, err := Decode("Pacific/Auckland")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	aucklandHours := WorkingHours{Zone: auckland, Start: 9 * time.Hour, End: 17 * time.Hour}
+
+	monday := berlin.Date(2026, time.March, 23, 0, 0, 0, 0)
+	nextMonday := berlin.Date(2026, time.March, 30, 0, 0, 0, 0)
+	sunday := berlin.Date(2026, time.March, 29, 0, 0, 0, 0)
+
+	tests := []struct {
+		name  string
+		hours WorkingHours
+		a, b  time.Time
+		want  time.Duration
+	}{
+		{"week", nineToFive, monday, nextMonday, 40 * time.Hour},
+		{"reversed", nineToFive, nextMonday, monday, -40 * time.Hour},
+		{"holiday", withHoliday, monday, nextMonday, 32 * time.Hour},
+		{"partial day", nineToFive, monday.Add(12 * time.Hour), monday.Add(20 * time.Hour), 5 * time.Hour},
+		{"DST day", allDay, sunday, nextMonday, 23 * time.Hour},
+		{"empty", nineToFive, monday, monday, 0},
+		{"east of UTC", aucklandHours, auckland.Date(2026, time.March, 23, 10, 0, 0, 0), auckland.Date(2026, time.March, 30, 10, 0, 0, 0), 40 * time.Hour},
+		{"east of UTC over DST end", aucklandHours, auckland.Date(2026, time.March, 30, 0, 0, 0, 0), auckland.Date(2026, time.April, 13, 0, 0, 0, 0), 80 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.hours.BusinessDurationBetween(tt.a, tt.b); got != tt.want {
+				t.Errorf("BusinessDurationBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Examples.
+
+func ExampleWorkingHours_AddBusinessDuration() {
+	berlin, err := Decode("Europe/Berlin")
+	if err != nil {
+		panic(err)
+	}
+
+	hours := WorkingHours{Zone: berlin, Start: 9 * time.Hour, End: 17 * time.Hour}
+
+	// A ticket opened on Friday afternoon with an 8 business-hour SLA.
+	opened := berlin.Date(2026, time.March, 27, 15, 0, 0, 0)
+	due := hours.AddBusinessDuration(opened, 8*time.Hour)
+
+	fmt.Println(berlin.In(due).Format("Mon 2006-01-02 15:04 MST"))
+	// Output: Mon 2026-03-30 15:00 CEST
+}
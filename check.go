@@ -0,0 +1,170 @@
+package tz
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DriftKind identifies what differs between a registry and zoneinfo.
+type DriftKind string
+
+// Drift kinds reported by Check.
+const (
+	// DriftMissing reports a zone without a zoneinfo file.
+	DriftMissing DriftKind = "missing"
+	// DriftOffset reports a standard UTC offset that differs from zoneinfo.
+	DriftOffset DriftKind = "offset"
+	// DriftCountry reports a country code not listed for the zone in
+	// zone1970.tab.
+	DriftCountry DriftKind = "country"
+)
+
+// Drift is a difference between a zone in a registry and a compiled zoneinfo
+// tree.
+type Drift struct {
+	Identifier string
+	Kind       DriftKind
+	// Dataset and Zoneinfo are the differing values, such as offsets
+	// "-04:00" and "-03:00", or country codes. Zoneinfo lists every country
+	// of a zone shared by several countries, separated by commas. An empty
+	// value means the zone has no country.
+	Dataset, Zoneinfo string
+}
+
+// String returns a description such as
+// "America/Asuncion: offset -04:00, zoneinfo -03:00".
+func (d Drift) String() string {
+	if d.Kind == DriftMissing {
+		return d.Identifier + ": missing from zoneinfo"
+	}
+
+	return fmt.Sprintf("%s: %s %s, zoneinfo %s", d.Identifier, d.Kind, cmp.Or(d.Dataset, "none"), cmp.Or(d.Zoneinfo, "none"))
+}
+
+// Check compares the default registry with the host's compiled zoneinfo, as
+// found in /usr/share/zoneinfo or another standard location. See
+// Registry.CheckFS for details.
+func Check() ([]Drift, error) {
+	for _, dir := range zoneinfoDirs {
+		fsys := os.DirFS("/" + dir)
+
+		if _, err := fs.Stat(fsys, "zone1970.tab"); err == nil {
+			return Default().CheckFS(fsys)
+		}
+	}
+
+	return nil, fmt.Errorf("zoneinfo: %w", fs.ErrNotExist)
+}
+
+// CheckDir compares the registry with a compiled zoneinfo directory such as
+// /usr/share/zoneinfo. See CheckFS for details.
+func (r *Registry) CheckDir(dir string) ([]Drift, error) {
+	return r.CheckFS(os.DirFS(dir))
+}
+
+// CheckFS compares every zone in the registry with a compiled zoneinfo tree
+// and returns the drift, sorted by identifier. Each zone must have a TZif
+// file with the same standard offset in the current year, and its country
+// must be listed for it in zone1970.tab, or in zone.tab for zones that
+// zone1970.tab omits; zones listed in neither must have no country.
+//
+// The standard offset is the lowest offset in effect during the year, as
+// for ZoneData.UtcOffset and LoadFS. Zones that tzdata models with negative
+// DST are therefore compared against their winter or Ramadan offset:
+// Europe/Dublin against GMT (+00:00) rather than IST, and Africa/Casablanca
+// against +00:00 rather than +01:00.
+func (r *Registry) CheckFS(fsys fs.FS) ([]Drift, error) {
+	countries, err := readCountryTabs(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	year := time.Now().Year()
+
+	var drift []Drift
+
+	for _, tz := range r.sorted {
+		data, err := fs.ReadFile(fsys, tz.identifier)
+		if errors.Is(err, fs.ErrNotExist) {
+			drift = append(drift, Drift{Identifier: tz.identifier, Kind: DriftMissing})
+
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("read zone %q: %w", tz.identifier, err)
+		}
+
+		loc, err := time.LoadLocationFromTZData(tz.identifier, data)
+		if err != nil {
+			return nil, fmt.Errorf("zone %q: %w: %w", tz.identifier, ErrInvalidZone, err)
+		}
+
+		if offset := lowestOffset(loc, year); offset != tz.utcOffset {
+			drift = append(drift, Drift{
+				Identifier: tz.identifier,
+				Kind:       DriftOffset,
+				Dataset:    FormatOffset(tz.utcOffset),
+				Zoneinfo:   FormatOffset(offset),
+			})
+		}
+
+		codes := countries[tz.identifier]
+		if tz.countryCode == "" && len(codes) > 0 || tz.countryCode != "" && !slices.Contains(codes, tz.countryCode) {
+			drift = append(drift, Drift{
+				Identifier: tz.identifier,
+				Kind:       DriftCountry,
+				Dataset:    tz.countryCode,
+				Zoneinfo:   strings.Join(codes, ","),
+			})
+		}
+	}
+
+	return drift, nil
+}
+
+// readCountryTabs maps identifiers to their country codes, from zone1970.tab
+// and, for identifiers it omits, zone.tab.
+func readCountryTabs(fsys fs.FS) (map[string][]string, error) {
+	countries := make(map[string][]string)
+
+	for _, name := range []string{"zone1970.tab", "zone.tab"} {
+		tab, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", name, err)
+		}
+
+		scanner := bufio.NewScanner(bytes.NewReader(tab))
+
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" || line[0] == '#' {
+				continue
+			}
+
+			// Columns: country codes, coordinates, identifier, optional comments.
+			fields := strings.Split(line, "\t")
+			if len(fields) < 3 {
+				return nil, fmt.Errorf("%s: malformed line %q: %w", name, line, ErrInvalidZone)
+			}
+
+			if _, ok := countries[fields[2]]; !ok {
+				countries[fields[2]] = strings.Split(fields[0], ",")
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("read %s: %w", name, err)
+		}
+	}
+
+	return countries, nil
+}
@@ -0,0 +1,138 @@
+package tz
+
+import (
+	"os"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestCheckFS(t *testing.T) {
+	t.Parallel()
+
+	const dir = "/usr/share/zoneinfo"
+
+	fsys := fstest.MapFS{
+		"zone1970.tab": {Data: []byte("# comment\nDE,DK,NO,SE,SJ\t+5230+01322\tEurope/Berlin\nJP\t+353916+1394441\tAsia/Tokyo\nFR,MC\t+4852+00220\tEurope/Paris\n")},
+		"zone.tab":     {Data: []byte("NO\t+5955+01045\tEurope/Oslo\n")},
+	}
+
+	for _, id := range []string{"Europe/Berlin", "Europe/Oslo", "Asia/Tokyo", "Europe/Paris", "Etc/UTC"} {
+		data, err := os.ReadFile(dir + "/" + id)
+		if err != nil {
+			t.Skipf("system zoneinfo not available: %v", err)
+		}
+
+		fsys[id] = &fstest.MapFile{Data: data}
+	}
+
+	r, err := NewRegistry([]ZoneData{
+		{Identifier: "Europe/Berlin", CountryCode: "DE", UtcOffset: 1},
+		{Identifier: "Europe/Oslo", CountryCode: "NO", UtcOffset: 1},
+		{Identifier: "Asia/Tokyo", CountryCode: "JP", UtcOffset: 8},
+		{Identifier: "Europe/Paris", CountryCode: "DE", UtcOffset: 1},
+		{Identifier: "Etc/UTC"},
+		{Identifier: "Mars/Olympus_Mons", CountryCode: "MA", UtcOffset: 1},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	drift, err := r.CheckFS(fsys)
+	if err != nil {
+		t.Fatalf("CheckFS() error: %v", err)
+	}
+
+	want := []Drift{
+		{Identifier: "Asia/Tokyo", Kind: DriftOffset, Dataset: "+08:00", Zoneinfo: "+09:00"},
+		{Identifier: "Europe/Paris", Kind: DriftCountry, Dataset: "DE", Zoneinfo: "FR,MC"},
+		{Identifier: "Mars/Olympus_Mons", Kind: DriftMissing},
+	}
+
+	if !slices.Equal(drift, want) {
+		t.Errorf("CheckFS() = %v, want %v", drift, want)
+	}
+}
+
+func TestCheckFSMissingTab(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Default().CheckFS(fstest.MapFS{}); err == nil {
+		t.Error("CheckFS() without zone1970.tab returned nil error")
+	}
+}
+
+func TestDriftString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		drift Drift
+		want  string
+	}{
+		{Drift{Identifier: "America/Asuncion", Kind: DriftOffset, Dataset: "-04:00", Zoneinfo: "-03:00"}, "America/Asuncion: offset -04:00, zoneinfo -03:00"},
+		{Drift{Identifier: "Asia/Istanbul", Kind: DriftCountry, Dataset: "TR"}, "Asia/Istanbul: country TR, zoneinfo none"},
+		{Drift{Identifier: "Mars/Olympus_Mons", Kind: DriftMissing}, "Mars/Olympus_Mons: missing from zoneinfo"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.drift.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestCheckFSNegativeDST(t *testing.T) {
+	t.Parallel()
+
+	const dir = "/usr/share/zoneinfo"
+
+	// tzdata models Irish winter time and Moroccan Ramadan time as negative
+	// DST; the check compares against those lower offsets.
+	fsys := fstest.MapFS{
+		"zone1970.tab": {Data: []byte("IE\t+5320-00615\tEurope/Dublin\nMA\t+3339-00735\tAfrica/Casablanca\nGB,GG,IM,JE\t+513030-0000731\tEurope/London\n")},
+		"zone.tab":     {Data: []byte("IE\t+5320-00615\tEurope/Dublin\nMA\t+3339-00735\tAfrica/Casablanca\nGB\t+513030-0000731\tEurope/London\n")},
+	}
+
+	for _, id := range []string{"Europe/Dublin", "Africa/Casablanca", "Europe/London", "Etc/UTC", "UTC"} {
+		data, err := os.ReadFile(dir + "/" + id)
+		if err != nil {
+			t.Skipf("system zoneinfo not available: %v", err)
+		}
+
+		fsys[id] = &fstest.MapFile{Data: data}
+	}
+
+	// A registry loaded from the tree stores the same standard offsets.
+	loaded, err := LoadFS(fsys)
+	if err != nil {
+		t.Fatalf("LoadFS() error: %v", err)
+	}
+
+	if drift, err := loaded.CheckFS(fsys); err != nil || len(drift) != 0 {
+		t.Errorf("CheckFS() of LoadFS() registry = %v, %v, want no drift", drift, err)
+	}
+
+	// Summer offsets are reported.
+	r, err := NewRegistry([]ZoneData{
+		{Identifier: "Europe/Dublin", CountryCode: "IE", UtcOffset: 1},
+		{Identifier: "Africa/Casablanca", CountryCode: "MA", UtcOffset: 1},
+		{Identifier: "Europe/London", CountryCode: "GB", UtcOffset: 0, Rule: "GMT0BST,M3.5.0/1,M10.5.0"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	drift, err := r.CheckFS(fsys)
+	if err != nil {
+		t.Fatalf("CheckFS() error: %v", err)
+	}
+
+	want := []Drift{
+		{Identifier: "Africa/Casablanca", Kind: DriftOffset, Dataset: "+01:00", Zoneinfo: "+00:00"},
+		{Identifier: "Europe/Dublin", Kind: DriftOffset, Dataset: "+01:00", Zoneinfo: "+00:00"},
+	}
+
+	if !slices.Equal(drift, want) {
+		t.Errorf("CheckFS() = %v, want %v", drift, want)
+	}
+}
@@ -0,0 +1,560 @@
+// Command tz looks up timezones and converts times using the
+// github.com/infobits-io/tz dataset, without relying on the host's zoneinfo.
+//
+// Usage:
+//
+//	tz lookup <zone>                              show a timezone
+//	tz country <code>                             list the timezones of a country
+//	tz offset <±hh:mm>                            list timezones with an offset
+//	tz now <zone>...                              show the current time in zones
+//	tz convert <time> [-from zone] [-to zone]     convert a wall-clock time
+//	tz transitions <zone> [year]                  list DST transitions
+//	tz check [-zoneinfo dir]                      compare the dataset with zoneinfo
+//
+// Every command accepts -json to print JSON instead of a table. Zones are
+// parsed with tz.Parse, so aliases, "local" and fixed offsets such as
+// "UTC+05:30" are accepted.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/infobits-io/tz"
+)
+
+// errUsage reports invalid command-line arguments.
+var errUsage = errors.New("usage")
+
+// timeLayouts are the layouts accepted by convert, tried in order.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, time.Now))
+}
+
+// command is a subcommand of tz.
+type command struct {
+	usage string
+	run   func(c *cli, args []string) error
+}
+
+var commands = map[string]command{
+	"lookup":      {usage: "lookup <zone>", run: (*cli).lookup},
+	"country":     {usage: "country <code>", run: (*cli).country},
+	"offset":      {usage: "offset <±hh:mm>", run: (*cli).offset},
+	"now":         {usage: "now <zone>...", run: (*cli).now},
+	"convert":     {usage: "convert <time> [-from zone] [-to zone]", run: (*cli).convert},
+	"transitions": {usage: "transitions <zone> [year]", run: (*cli).transitions},
+	"check":       {usage: "check [-zoneinfo dir]", run: (*cli).check},
+}
+
+// cli holds the state of one invocation.
+type cli struct {
+	stdout io.Writer
+	flags  *flag.FlagSet
+	json   bool
+	clock  func() time.Time
+}
+
+// run executes the command line and returns the process exit code.
+func run(args []string, stdout, stderr io.Writer, clock func() time.Time) int {
+	logger := log.New(stderr, "", 0)
+
+	if len(args) == 0 {
+		usage(logger)
+
+		return 2
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		logger.Printf("tz: unknown command %q", args[0])
+		usage(logger)
+
+		return 2
+	}
+
+	c := &cli{stdout: stdout, flags: flag.NewFlagSet(args[0], flag.ContinueOnError), clock: clock}
+	c.flags.SetOutput(stderr)
+	c.flags.BoolVar(&c.json, "json", false, "print JSON instead of a table")
+
+	err := cmd.run(c, args[1:])
+
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		logger.Printf("usage: tz %s [-json]", cmd.usage)
+
+		return 2
+	default:
+		logger.Printf("tz: %v", err)
+
+		return 1
+	}
+}
+
+func usage(logger *log.Logger) {
+	logger.Print("usage: tz <command> [arguments] [-json]\n\ncommands:")
+
+	for _, name := range slices.Sorted(maps.Keys(commands)) {
+		logger.Printf("  tz %s", commands[name].usage)
+	}
+}
+
+// parse parses flags anywhere among the arguments and returns the positional
+// arguments, requiring between lo and hi of them (hi < 0 means unbounded).
+// Arguments that look like negative numbers, such as the offset "-3:00", are
+// positional.
+func (c *cli) parse(args []string, lo, hi int) ([]string, error) {
+	var positional []string
+
+	for len(args) > 0 {
+		if isNegativeNumber(args[0]) {
+			positional = append(positional, args[0])
+			args = args[1:]
+
+			continue
+		}
+
+		// Parse flags up to the next negative number, which the flag set
+		// would otherwise reject as an undefined flag.
+		end := slices.IndexFunc(args, isNegativeNumber)
+		if end < 0 {
+			end = len(args)
+		}
+
+		if err := c.flags.Parse(args[:end]); err != nil {
+			return nil, err
+		}
+
+		rest := c.flags.Args()
+		args = args[end:]
+
+		if len(rest) > 0 {
+			positional = append(positional, rest[0])
+			args = slices.Concat(rest[1:], args)
+		}
+	}
+
+	if len(positional) < lo || hi >= 0 && len(positional) > hi {
+		return nil, errUsage
+	}
+
+	return positional, nil
+}
+
+// isNegativeNumber reports whether arg is a minus sign followed by a digit.
+func isNegativeNumber(arg string) bool {
+	return len(arg) > 1 && arg[0] == '-' && arg[1] >= '0' && arg[1] <= '9'
+}
+
+// zoneJSON is the JSON form of a timezone at an instant.
+type zoneJSON struct {
+	ID            string   `json:"id"`
+	Country       string   `json:"country,omitempty"`
+	CountryName   string   `json:"countryName,omitempty"`
+	Offset        string   `json:"offset"`
+	CurrentOffset string   `json:"currentOffset"`
+	Abbreviation  string   `json:"abbreviation"`
+	DST           bool     `json:"dst"`
+	Rule          string   `json:"rule,omitempty"`
+	Aliases       []string `json:"aliases,omitempty"`
+}
+
+func (c *cli) zone(zone tz.Timezone) zoneJSON {
+	local := zone.In(c.clock())
+	abbreviation, offset := local.Zone()
+
+	v := zoneJSON{
+		ID:            zone.Identifier(),
+		Country:       zone.CountryCode(),
+		Offset:        tz.FormatOffset(zone.UtcOffset()),
+		CurrentOffset: tz.FormatOffset(float32(offset) / 3600),
+		Abbreviation:  abbreviation,
+		DST:           zone.IsDST(local),
+		Rule:          zone.Rule(),
+	}
+
+	if country, ok := zone.Country(); ok {
+		v.CountryName = country.Name()
+	}
+
+	return v
+}
+
+func (c *cli) lookup(args []string) error {
+	args, err := c.parse(args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	zone, err := tz.Parse(args[0])
+	if err != nil {
+		return err
+	}
+
+	v := c.zone(zone)
+
+	for alias, target := range tz.Default().Links() {
+		if target == v.ID {
+			v.Aliases = append(v.Aliases, alias)
+		}
+	}
+
+	slices.Sort(v.Aliases)
+
+	if c.json {
+		return c.printJSON(v)
+	}
+
+	return c.printTable(nil, [][]string{
+		{"Zone", v.ID},
+		{"Country", strings.TrimSpace(v.Country + " " + v.CountryName)},
+		{"Standard offset", v.Offset},
+		{"Current offset", v.CurrentOffset + " " + v.Abbreviation},
+		{"DST", strconv.FormatBool(v.DST)},
+		{"Rule", v.Rule},
+		{"Aliases", strings.Join(v.Aliases, ", ")},
+	})
+}
+
+func (c *cli) country(args []string) error {
+	args, err := c.parse(args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	country, err := tz.DecodeCountry(args[0])
+	if err != nil {
+		return err
+	}
+
+	return c.printZones(country.Timezones())
+}
+
+func (c *cli) offset(args []string) error {
+	args, err := c.parse(args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	s := args[0]
+	if !strings.HasPrefix(s, "+") && !strings.HasPrefix(s, "-") {
+		s = "+" + s
+	}
+
+	fixed, err := tz.Parse("UTC" + s)
+	if err != nil {
+		return fmt.Errorf("offset %q: malformed offset", args[0])
+	}
+
+	offset := fixed.UtcOffset()
+	now := c.clock()
+
+	var zones []tz.Timezone
+
+	for zone := range tz.Zones() {
+		if zone.UtcOffset() == offset || zone.OffsetAt(now) == offset {
+			zones = append(zones, zone)
+		}
+	}
+
+	return c.printZones(zones)
+}
+
+func (c *cli) printZones(zones []tz.Timezone) error {
+	values := make([]zoneJSON, 0, len(zones))
+	rows := make([][]string, 0, len(zones))
+
+	for _, zone := range zones {
+		v := c.zone(zone)
+		values = append(values, v)
+		rows = append(rows, []string{v.ID, v.Country, v.Offset, v.CurrentOffset, v.Abbreviation})
+	}
+
+	if c.json {
+		return c.printJSON(values)
+	}
+
+	return c.printTable([]string{"ZONE", "COUNTRY", "STANDARD", "CURRENT", "ABBR"}, rows)
+}
+
+// timeJSON is the JSON form of an instant in a timezone.
+type timeJSON struct {
+	Zone         string `json:"zone"`
+	Time         string `json:"time"`
+	Offset       string `json:"offset"`
+	Abbreviation string `json:"abbreviation"`
+}
+
+func newTimeJSON(zone tz.Timezone, instant time.Time) timeJSON {
+	local := zone.In(instant)
+	abbreviation, offset := local.Zone()
+
+	return timeJSON{
+		Zone:         zone.Identifier(),
+		Time:         local.Format(time.RFC3339),
+		Offset:       tz.FormatOffset(float32(offset) / 3600),
+		Abbreviation: abbreviation,
+	}
+}
+
+func (c *cli) printTimes(values []timeJSON) error {
+	if c.json {
+		return c.printJSON(values)
+	}
+
+	rows := make([][]string, len(values))
+	for i, v := range values {
+		rows[i] = []string{v.Zone, strings.Replace(v.Time[:19], "T", " ", 1), v.Offset, v.Abbreviation}
+	}
+
+	return c.printTable([]string{"ZONE", "TIME", "OFFSET", "ABBR"}, rows)
+}
+
+func (c *cli) now(args []string) error {
+	args, err := c.parse(args, 1, -1)
+	if err != nil {
+		return err
+	}
+
+	now := c.clock()
+	values := make([]timeJSON, 0, len(args))
+
+	for _, arg := range args {
+		zone, err := tz.Parse(arg)
+		if err != nil {
+			return err
+		}
+
+		values = append(values, newTimeJSON(zone, now))
+	}
+
+	return c.printTimes(values)
+}
+
+func (c *cli) convert(args []string) error {
+	var from, to tz.Timezone
+
+	c.flags.Var(&from, "from", "zone of the input time (default local)")
+	c.flags.Var(&to, "to", "zone to convert to (default local)")
+
+	args, err := c.parse(args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range []*tz.Timezone{&from, &to} {
+		if zone.Identifier() == "" {
+			if *zone, err = tz.Current(); err != nil {
+				return err
+			}
+		}
+	}
+
+	instant, err := parseTime(args[0], from)
+	if err != nil {
+		return err
+	}
+
+	return c.printTimes([]timeJSON{newTimeJSON(from, instant), newTimeJSON(to, instant)})
+}
+
+// parseTime parses s as an RFC 3339 instant, or as a wall-clock time in zone.
+func parseTime(s string, zone tz.Timezone) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+
+		if layout == time.RFC3339 {
+			return t, nil
+		}
+
+		return zone.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond()), nil
+	}
+
+	return time.Time{}, fmt.Errorf("time %q: want a time such as \"2006-01-02 15:04\"", s)
+}
+
+func (c *cli) transitions(args []string) error {
+	args, err := c.parse(args, 1, 2)
+	if err != nil {
+		return err
+	}
+
+	zone, err := tz.Parse(args[0])
+	if err != nil {
+		return err
+	}
+
+	year := c.clock().Year()
+
+	if len(args) == 2 {
+		if year, err = strconv.Atoi(args[1]); err != nil {
+			return fmt.Errorf("year %q: %w", args[1], errUsage)
+		}
+	}
+
+	type transitionJSON struct {
+		Time         string `json:"time"`
+		Offset       string `json:"offset"`
+		Abbreviation string `json:"abbreviation"`
+		DST          bool   `json:"dst"`
+	}
+
+	values := []transitionJSON{}
+	rows := [][]string{}
+
+	for _, t := range zone.Transitions(year) {
+		v := transitionJSON{
+			Time:         t.At.Format(time.RFC3339),
+			Offset:       tz.FormatOffset(t.Offset),
+			Abbreviation: t.Abbreviation,
+			DST:          t.DST,
+		}
+		values = append(values, v)
+		rows = append(rows, []string{t.At.Format("2006-01-02 15:04"), v.Offset, v.Abbreviation, strconv.FormatBool(v.DST)})
+	}
+
+	if c.json {
+		return c.printJSON(values)
+	}
+
+	if len(rows) == 0 {
+		_, err := io.WriteString(c.stdout, zone.Identifier()+" does not observe daylight saving time\n")
+
+		return err
+	}
+
+	return c.printTable([]string{"TIME", "OFFSET", "ABBR", "DST"}, rows)
+}
+
+// errDrift reports that check found differences from zoneinfo.
+var errDrift = errors.New("dataset differs from zoneinfo")
+
+// check compares the dataset with the host's zoneinfo, or the directory given
+// by -zoneinfo, and fails if any zone drifts.
+func (c *cli) check(args []string) error {
+	dir := c.flags.String("zoneinfo", "", "compiled zoneinfo directory (default: the host's)")
+
+	if _, err := c.parse(args, 0, 0); err != nil {
+		return err
+	}
+
+	var (
+		drift []tz.Drift
+		err   error
+	)
+
+	if *dir == "" {
+		drift, err = tz.Check()
+	} else {
+		drift, err = tz.Default().CheckDir(*dir)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	type driftJSON struct {
+		ID       string `json:"id"`
+		Kind     string `json:"kind"`
+		Dataset  string `json:"dataset,omitempty"`
+		Zoneinfo string `json:"zoneinfo,omitempty"`
+	}
+
+	values := []driftJSON{}
+	rows := [][]string{}
+
+	for _, d := range drift {
+		values = append(values, driftJSON{ID: d.Identifier, Kind: string(d.Kind), Dataset: d.Dataset, Zoneinfo: d.Zoneinfo})
+		rows = append(rows, []string{d.Identifier, string(d.Kind), d.Dataset, d.Zoneinfo})
+	}
+
+	if c.json {
+		err = c.printJSON(values)
+	} else if len(rows) > 0 {
+		err = c.printTable([]string{"ZONE", "CHECK", "DATASET", "ZONEINFO"}, rows)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(drift) > 0 {
+		return fmt.Errorf("%w: %d differences", errDrift, len(drift))
+	}
+
+	return nil
+}
+
+func (c *cli) printJSON(v any) error {
+	enc := json.NewEncoder(c.stdout)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+// printTable prints rows as left-aligned columns separated by two spaces.
+func (c *cli) printTable(header []string, rows [][]string) error {
+	if header != nil {
+		rows = append([][]string{header}, rows...)
+	}
+
+	var widths []int
+
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	var b strings.Builder
+
+	for _, row := range rows {
+		var line strings.Builder
+
+		for i, cell := range row {
+			line.WriteString(cell)
+
+			if i < len(row)-1 {
+				line.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)+2))
+			}
+		}
+
+		b.WriteString(strings.TrimRight(line.String(), " "))
+		b.WriteByte('\n')
+	}
+
+	_, err := io.WriteString(c.stdout, b.String())
+
+	return err
+}
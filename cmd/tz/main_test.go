@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/infobits-io/tz"
+)
+
+func fixedClock() time.Time {
+	return time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		args     []string
+		want     string
+		wantCode int
+	}{
+		{
+			name: "lookup",
+			args: []string{"lookup", "US/Eastern"},
+			want: "Zone             America/New_York\n" +
+				"Country          US United States\n" +
+				"Standard offset  -05:00\n" +
+				"Current offset   -04:00 EDT\n" +
+				"DST              true\n" +
+				"Rule             EST5EDT,M3.2.0,M11.1.0\n" +
+				"Aliases          US/Eastern\n",
+		},
+		{
+			name: "country",
+			args: []string{"country", "NZ"},
+			want: "ZONE              COUNTRY  STANDARD  CURRENT  ABBR\n" +
+				"Pacific/Auckland  NZ       +12:00    +12:00   NZST\n" +
+				"Pacific/Chatham   NZ       +12:45    +12:45   +1245\n",
+		},
+		{
+			name: "offset",
+			args: []string{"offset", "5:45"},
+			want: "ZONE            COUNTRY  STANDARD  CURRENT  ABBR\n" +
+				"Asia/Kathmandu  NP       +05:45    +05:45   +0545\n",
+		},
+		{
+			name: "negative offset",
+			args: []string{"offset", "-9:30"},
+			want: "ZONE               COUNTRY  STANDARD  CURRENT  ABBR\n" +
+				"Pacific/Marquesas  PF       -09:30    -09:30   -0930\n",
+		},
+		{
+			name: "negative offset after flag",
+			args: []string{"offset", "-json", "-9:30"},
+			want: "[\n  {\n    \"id\": \"Pacific/Marquesas\",\n    \"country\": \"PF\",\n    \"countryName\": \"French Polynesia\",\n" +
+				"    \"offset\": \"-09:30\",\n    \"currentOffset\": \"-09:30\",\n    \"abbreviation\": \"-0930\",\n    \"dst\": false\n  }\n]\n",
+		},
+		{
+			name: "now",
+			args: []string{"now", "Asia/Tokyo", "America/Chicago"},
+			want: "ZONE             TIME                 OFFSET  ABBR\n" +
+				"Asia/Tokyo       2026-07-01 21:00:00  +09:00  +09\n" +
+				"America/Chicago  2026-07-01 07:00:00  -05:00  CDT\n",
+		},
+		{
+			name: "convert",
+			args: []string{"convert", "2026-11-01 01:30", "--from", "America/New_York", "--to", "UTC"},
+			want: "ZONE              TIME                 OFFSET  ABBR\n" +
+				"America/New_York  2026-11-01 01:30:00  -04:00  EDT\n" +
+				"UTC               2026-11-01 05:30:00  +00:00  UTC\n",
+		},
+		{
+			name: "transitions",
+			args: []string{"transitions", "Europe/London", "2026"},
+			want: "TIME              OFFSET  ABBR  DST\n" +
+				"2026-03-29 02:00  +01:00  BST   true\n" +
+				"2026-10-25 01:00  +00:00  GMT   false\n",
+		},
+		{
+			name: "no transitions",
+			args: []string{"transitions", "Asia/Tokyo"},
+			want: "Asia/Tokyo does not observe daylight saving time\n",
+		},
+		{name: "unknown zone", args: []string{"lookup", "Berln"}, wantCode: 1},
+		{name: "check without zoneinfo", args: []string{"check", "-zoneinfo", "/nonexistent"}, wantCode: 1},
+		{name: "unknown command", args: []string{"frobnicate"}, wantCode: 2},
+		{name: "missing argument", args: []string{"lookup"}, wantCode: 2},
+		{name: "no command", wantCode: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout, stderr bytes.Buffer
+
+			if code := run(tt.args, &stdout, &stderr, fixedClock); code != tt.wantCode {
+				t.Fatalf("exit code = %d, want %d (stderr %q)", code, tt.wantCode, stderr.String())
+			}
+
+			if stdout.String() != tt.want {
+				t.Errorf("output =\n%s\nwant\n%s", stdout.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRunJSON(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	if code := run([]string{"lookup", "-json", "Europe/Oslo"}, &stdout, &stderr, fixedClock); code != 0 {
+		t.Fatalf("exit code = %d (stderr %q)", code, stderr.String())
+	}
+
+	var got zoneJSON
+
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", stdout.String(), err)
+	}
+
+	if got.ID != "Europe/Oslo" || got.CurrentOffset != "+02:00" || !got.DST || !strings.Contains(strings.Join(got.Aliases, " "), "Arctic/Longyearbyen") {
+		t.Errorf("lookup -json = %+v", got)
+	}
+}
+
+func TestRunCheck(t *testing.T) {
+	t.Parallel()
+
+	// A zoneinfo tree with tables but no zones reports every zone missing.
+	dir := t.TempDir()
+
+	for _, name := range []string{"zone1970.tab", "zone.tab"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	if code := run([]string{"check", "-zoneinfo", dir, "-json"}, &stdout, &stderr, fixedClock); code != 1 {
+		t.Fatalf("exit code = %d, want 1 (stderr %q)", code, stderr.String())
+	}
+
+	var got []struct {
+		ID   string `json:"id"`
+		Kind string `json:"kind"`
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", stdout.String(), err)
+	}
+
+	if len(got) != tz.Default().Len() {
+		t.Fatalf("check -json = %d drifts, want %d", len(got), tz.Default().Len())
+	}
+
+	if got[0].ID != "Africa/Abidjan" || got[0].Kind != "missing" {
+		t.Errorf("check -json first drift = %+v, want Africa/Abidjan missing", got[0])
+	}
+}
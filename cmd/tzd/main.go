@@ -0,0 +1,419 @@
+// Command tzd serves the github.com/infobits-io/tz dataset over HTTP, for
+// services that need authoritative timezone lookups without bundling data.
+//
+// Usage:
+//
+//	tzd [-addr :8080] [-data dataset]
+//
+// Endpoints, all returning JSON:
+//
+//	GET /zones                       all zones
+//	GET /zones/{id}                  one zone; aliases resolve to their target
+//	GET /countries/{code}/zones      zones of a country (alpha-2 or alpha-3)
+//	GET /offsets/{offset}/zones      zones with a standard offset such as +05:30;
+//	                                 with ?at=<RFC 3339 time>, the offset at that time
+//	GET /convert?time=&from=&to=     convert a wall-clock time between zones
+//	GET /now/{id}                    current time in a zone
+//	GET /healthz                     health check with the dataset version
+//
+// Dataset responses carry an ETag derived from the dataset version and honor
+// If-None-Match. With -data, the dataset is loaded from a file through
+// tz.LoadFile and reloaded on SIGHUP.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/infobits-io/tz"
+)
+
+// timeLayouts are the wall-clock layouts accepted by /convert, tried in order.
+var timeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+}
+
+func main() {
+	addr := flag.String("addr", ":8080", "listen address")
+	data := flag.String("data", "", "dataset file to serve instead of the embedded dataset")
+	flag.Parse()
+
+	if err := run(*addr, *data); err != nil {
+		log.Fatalf("tzd: %v", err)
+	}
+}
+
+func run(addr, data string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if data != "" {
+		if err := tz.ReloadFile(data); err != nil {
+			return err
+		}
+
+		go reloadOnHangup(ctx, data)
+	}
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           newHandler(time.Now),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(shutdown); err != nil {
+			log.Printf("tzd: shutdown: %v", err)
+		}
+	}()
+
+	log.Printf("tzd: serving dataset %s on %s", tz.Default().Version(), addr)
+
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// reloadOnHangup reloads the dataset file whenever the process receives SIGHUP.
+func reloadOnHangup(ctx context.Context, path string) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+
+	defer signal.Stop(hangup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			if err := tz.ReloadFile(path); err != nil {
+				log.Printf("tzd: reload: %v", err)
+
+				continue
+			}
+
+			log.Printf("tzd: reloaded dataset %s", tz.Default().Version())
+		}
+	}
+}
+
+// server answers requests against the default registry.
+type server struct {
+	clock func() time.Time
+}
+
+func newHandler(clock func() time.Time) http.Handler {
+	s := &server{clock: clock}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /healthz", s.health)
+	mux.HandleFunc("GET /zones", s.cached(s.zones))
+	mux.HandleFunc("GET /zones/{id...}", s.cached(s.zone))
+	mux.HandleFunc("GET /countries/{code}/zones", s.cached(s.country))
+	mux.HandleFunc("GET /offsets/{offset}/zones", s.cached(s.offset))
+	mux.HandleFunc("GET /convert", s.cached(s.convert))
+	mux.HandleFunc("GET /now/{id...}", s.now)
+
+	return mux
+}
+
+// httpError is an error with an HTTP status code.
+type httpError struct {
+	status int
+	msg    string
+}
+
+func (e *httpError) Error() string {
+	return e.msg
+}
+
+// cached wraps a handler whose response depends only on the request and the
+// dataset, adding an ETag derived from the dataset version.
+func (s *server) cached(h func(r *http.Request, reg *tz.Registry) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		reg := tz.Default()
+		etag := `"` + reg.Version() + `"`
+
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "public, no-cache")
+
+		if noneMatch(r.Header.Values("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		v, err := h(r, reg)
+		writeJSON(w, v, err)
+	}
+}
+
+// noneMatch reports whether an If-None-Match header, given as its field
+// values, matches etag. Per RFC 9110, section 13.1.2, the header is "*" or a
+// comma-separated list of entity tags compared weakly, so W/"v" matches "v".
+// Parsing stops at the first malformed tag.
+func noneMatch(values []string, etag string) bool {
+	for _, s := range values {
+		if strings.TrimSpace(s) == "*" {
+			return true
+		}
+
+		for {
+			s = strings.TrimLeft(s, " \t,")
+			s = strings.TrimPrefix(s, "W/")
+
+			if s == "" || s[0] != '"' {
+				break
+			}
+
+			end := strings.IndexByte(s[1:], '"')
+			if end < 0 {
+				break
+			}
+
+			if s[:end+2] == etag {
+				return true
+			}
+
+			s = s[end+2:]
+		}
+	}
+
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, v any, err error) {
+	status := http.StatusOK
+
+	if err != nil {
+		status = http.StatusInternalServerError
+
+		var herr *httpError
+		if errors.As(err, &herr) {
+			status = herr.status
+		}
+
+		w.Header().Del("ETag")
+		v = map[string]string{"error": err.Error()}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		log.Printf("tzd: write response: %v", err)
+	}
+}
+
+// zoneJSON is the JSON form of a timezone.
+type zoneJSON struct {
+	ID      string   `json:"id"`
+	Country string   `json:"country"`
+	Offset  string   `json:"offset"`
+	Rule    string   `json:"rule,omitempty"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+func newZoneJSON(zone tz.Timezone) zoneJSON {
+	return zoneJSON{ID: zone.Identifier(), Country: zone.CountryCode(), Offset: tz.FormatOffset(zone.UtcOffset()), Rule: zone.Rule()}
+}
+
+func zoneList(zones []tz.Timezone) []zoneJSON {
+	result := make([]zoneJSON, 0, len(zones))
+
+	for _, zone := range zones {
+		result = append(result, newZoneJSON(zone))
+	}
+
+	return result
+}
+
+func (s *server) health(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+
+	writeJSON(w, map[string]any{"status": "ok", "version": tz.Default().Version(), "zones": tz.Default().Len()}, nil)
+}
+
+func (s *server) zones(_ *http.Request, reg *tz.Registry) (any, error) {
+	var zones []tz.Timezone
+
+	for zone := range reg.Zones() {
+		zones = append(zones, zone)
+	}
+
+	return zoneList(zones), nil
+}
+
+func (s *server) zone(r *http.Request, reg *tz.Registry) (any, error) {
+	zone, err := decode(reg, r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	v := newZoneJSON(zone)
+
+	for alias, target := range reg.Links() {
+		if target == v.ID {
+			v.Aliases = append(v.Aliases, alias)
+		}
+	}
+
+	slices.Sort(v.Aliases)
+
+	return v, nil
+}
+
+func (s *server) country(r *http.Request, reg *tz.Registry) (any, error) {
+	country, err := tz.DecodeCountry(r.PathValue("code"))
+	if err != nil {
+		return nil, &httpError{status: http.StatusNotFound, msg: err.Error()}
+	}
+
+	return zoneList(reg.ByCountryCode(country.Code())), nil
+}
+
+func (s *server) offset(r *http.Request, reg *tz.Registry) (any, error) {
+	offset, err := parseOffset(r.PathValue("offset"))
+	if err != nil {
+		return nil, err
+	}
+
+	at := r.URL.Query().Get("at")
+	if at == "" {
+		return zoneList(reg.ByUtcOffset(offset)), nil
+	}
+
+	instant, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return nil, &httpError{status: http.StatusBadRequest, msg: "at: want an RFC 3339 time"}
+	}
+
+	return zoneList(reg.ByOffsetAt(offset, instant)), nil
+}
+
+// timeJSON is the JSON form of an instant in a timezone.
+type timeJSON struct {
+	Zone         string `json:"zone"`
+	Time         string `json:"time"`
+	Offset       string `json:"offset"`
+	Abbreviation string `json:"abbreviation"`
+	DST          bool   `json:"dst"`
+}
+
+func newTimeJSON(zone tz.Timezone, instant time.Time) timeJSON {
+	local := zone.In(instant)
+	abbreviation, offset := local.Zone()
+
+	return timeJSON{
+		Zone:         zone.Identifier(),
+		Time:         local.Format(time.RFC3339),
+		Offset:       tz.FormatOffset(float32(offset) / 3600),
+		Abbreviation: abbreviation,
+		DST:          zone.IsDST(instant),
+	}
+}
+
+func (s *server) convert(r *http.Request, reg *tz.Registry) (any, error) {
+	query := r.URL.Query()
+
+	from, err := decode(reg, query.Get("from"))
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := decode(reg, query.Get("to"))
+	if err != nil {
+		return nil, err
+	}
+
+	instant, ok := parseTime(query.Get("time"), from)
+	if !ok {
+		return nil, &httpError{status: http.StatusBadRequest, msg: `time: want a time such as "2006-01-02 15:04" or RFC 3339`}
+	}
+
+	return map[string]timeJSON{"from": newTimeJSON(from, instant), "to": newTimeJSON(to, instant)}, nil
+}
+
+func (s *server) now(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+
+	zone, err := decode(tz.Default(), r.PathValue("id"))
+	if err != nil {
+		writeJSON(w, nil, err)
+
+		return
+	}
+
+	writeJSON(w, newTimeJSON(zone, s.clock()), nil)
+}
+
+// decode resolves an identifier or alias, reporting unknown zones as 404.
+func decode(reg *tz.Registry, id string) (tz.Timezone, error) {
+	if id == "" {
+		return tz.Timezone{}, &httpError{status: http.StatusBadRequest, msg: "missing timezone"}
+	}
+
+	zone, err := reg.Decode(id)
+	if err != nil {
+		return tz.Timezone{}, &httpError{status: http.StatusNotFound, msg: err.Error()}
+	}
+
+	return zone, nil
+}
+
+// parseTime parses s as an RFC 3339 instant, or as a wall-clock time in zone.
+func parseTime(s string, zone tz.Timezone) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, true
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return zone.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond()), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseOffset parses an offset such as "+05:30", "-3" or "5.5" in hours.
+func parseOffset(s string) (float32, error) {
+	if !strings.HasPrefix(s, "+") && !strings.HasPrefix(s, "-") {
+		s = "+" + s
+	}
+
+	if h, err := strconv.ParseFloat(s, 32); err == nil {
+		return float32(h), nil
+	}
+
+	fixed, err := tz.Parse("UTC" + s)
+	if err != nil {
+		return 0, &httpError{status: http.StatusBadRequest, msg: "offset: want an offset such as +05:30"}
+	}
+
+	return fixed.UtcOffset(), nil
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/infobits-io/tz"
+)
+
+func fixedClock() time.Time {
+	return time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func get(t *testing.T, h http.Handler, target string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, target, nil)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		target     string
+		wantStatus int
+		want       string
+	}{
+		{"/zones/Europe/Oslo", http.StatusOK, `{"id":"Europe/Oslo","country":"NO","offset":"+01:00","rule":"CET-1CEST,M3.5.0,M10.5.0/3","aliases":["Arctic/Longyearbyen","Atlantic/Jan_Mayen"]}`},
+		{"/zones/Asia/Calcutta", http.StatusOK, `{"id":"Asia/Kolkata","country":"IN","offset":"+05:30","aliases":["Asia/Calcutta"]}`},
+		{"/zones/Mars/Olympus", http.StatusNotFound, `{"error":"timezone \"Mars/Olympus\": timezone not found"}`},
+		{"/countries/NZL/zones", http.StatusOK, `[{"id":"Pacific/Auckland","country":"NZ","offset":"+12:00","rule":"NZST-12NZDT,M9.5.0,M4.1.0/3"},{"id":"Pacific/Chatham","country":"NZ","offset":"+12:45","rule":"<+1245>-12:45<+1345>,M9.5.0/2:45,M4.1.0/3:45"}]`},
+		{"/countries/XX/zones", http.StatusNotFound, ""},
+		{"/offsets/+05:45/zones", http.StatusOK, `[{"id":"Asia/Kathmandu","country":"NP","offset":"+05:45"}]`},
+		{"/offsets/5.75/zones", http.StatusOK, `[{"id":"Asia/Kathmandu","country":"NP","offset":"+05:45"}]`},
+		{"/offsets/12.75/zones?at=2026-01-01T00:00:00Z", http.StatusOK, `[]`},
+		{"/offsets/abc/zones", http.StatusBadRequest, ""},
+		{"/convert?time=2026-11-01+01:30&from=America/New_York&to=UTC", http.StatusOK, `{"from":{"zone":"America/New_York","time":"2026-11-01T01:30:00-04:00","offset":"-04:00","abbreviation":"EDT","dst":true},"to":{"zone":"UTC","time":"2026-11-01T05:30:00Z","offset":"+00:00","abbreviation":"UTC","dst":false}}`},
+		{"/convert?time=tomorrow&from=UTC&to=UTC", http.StatusBadRequest, ""},
+		{"/convert?time=2026-01-01+00:00&to=UTC", http.StatusBadRequest, ""},
+		{"/now/Asia/Tokyo", http.StatusOK, `{"zone":"Asia/Tokyo","time":"2026-07-01T21:00:00+09:00","offset":"+09:00","abbreviation":"+09","dst":false}`},
+	}
+
+	h := newHandler(fixedClock)
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			t.Parallel()
+
+			rec := get(t, h, tt.target, nil)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			if got := rec.Body.String(); tt.want != "" && got != tt.want+"\n" {
+				t.Errorf("body = %s, want %s", got, tt.want)
+			}
+
+			if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+				t.Errorf("Content-Type = %q", ct)
+			}
+		})
+	}
+}
+
+func TestHandlerETag(t *testing.T) {
+	t.Parallel()
+
+	h := newHandler(fixedClock)
+
+	rec := get(t, h, "/zones", nil)
+	etag := rec.Header().Get("ETag")
+
+	if want := `"` + tz.Default().Version() + `"`; etag != want {
+		t.Fatalf("ETag = %q, want %q", etag, want)
+	}
+
+	var zones []zoneJSON
+	if err := json.Unmarshal(rec.Body.Bytes(), &zones); err != nil || len(zones) != len(tz.All()) {
+		t.Errorf("GET /zones returned %d zones (%v), want %d", len(zones), err, len(tz.All()))
+	}
+
+	if rec := get(t, h, "/zones", http.Header{"If-None-Match": {etag}}); rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("conditional GET status = %d, want 304", rec.Code)
+	}
+
+	if rec := get(t, h, "/zones", http.Header{"If-None-Match": {`"stale"`}}); rec.Code != http.StatusOK {
+		t.Errorf("stale conditional GET status = %d, want 200", rec.Code)
+	}
+
+	if rec := get(t, h, "/now/UTC", nil); rec.Header().Get("ETag") != "" {
+		t.Error("/now response has an ETag")
+	}
+}
+
+func TestHandlerIfNoneMatch(t *testing.T) {
+	t.Parallel()
+
+	h := newHandler(fixedClock)
+	etag := `"` + tz.Default().Version() + `"`
+
+	tests := []struct {
+		name   string
+		header []string
+		want   int
+	}{
+		{name: "exact", header: []string{etag}, want: http.StatusNotModified},
+		{name: "weak", header: []string{"W/" + etag}, want: http.StatusNotModified},
+		{name: "any", header: []string{"*"}, want: http.StatusNotModified},
+		{name: "list", header: []string{`"stale", ` + etag}, want: http.StatusNotModified},
+		{name: "list without spaces", header: []string{`W/"stale",W/` + etag}, want: http.StatusNotModified},
+		{name: "repeated header", header: []string{`"stale"`, etag}, want: http.StatusNotModified},
+		{name: "comma in tag", header: []string{`"stale,` + etag[1:]}, want: http.StatusOK},
+		{name: "stale list", header: []string{`"a", W/"b"`}, want: http.StatusOK},
+		{name: "unquoted", header: []string{strings.Trim(etag, `"`)}, want: http.StatusOK},
+		{name: "after malformed tag", header: []string{"stale, " + etag}, want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if rec := get(t, h, "/zones", http.Header{"If-None-Match": tt.header}); rec.Code != tt.want {
+				t.Errorf("If-None-Match %q: status = %d, want %d", tt.header, rec.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestHealth(t *testing.T) {
+	t.Parallel()
+
+	rec := get(t, newHandler(fixedClock), "/healthz", nil)
+
+	var got struct {
+		Status  string `json:"status"`
+		Version string `json:"version"`
+	}
+
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil || rec.Code != http.StatusOK || got.Status != "ok" || got.Version == "" {
+		t.Errorf("GET /healthz = %d %s", rec.Code, rec.Body)
+	}
+}
@@ -0,0 +1,125 @@
+package tz
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrCountryNotFound is returned when a country code is not in the dataset.
+var ErrCountryNotFound = errors.New("country not found")
+
+// Country holds ISO 3166-1 metadata for a country that has at least one timezone.
+type Country struct {
+	alpha2  string
+	alpha3  string
+	numeric string
+	name    string
+}
+
+// DecodeCountry looks up a country by its ISO 3166-1 alpha-2 or alpha-3 code.
+// Returns ErrCountryNotFound (wrapped) if the code is not recognized.
+func DecodeCountry(code string) (Country, error) {
+	if data, ok := countries[code]; ok {
+		return newCountry(code, data), nil
+	}
+
+	if len(code) == 3 {
+		for alpha2, data := range countries {
+			if data.alpha3 == code {
+				return newCountry(alpha2, data), nil
+			}
+		}
+	}
+
+	return Country{}, fmt.Errorf("country %q: %w", code, ErrCountryNotFound)
+}
+
+func newCountry(alpha2 string, data countryData) Country {
+	return Country{
+		alpha2:  alpha2,
+		alpha3:  data.alpha3,
+		numeric: data.numeric,
+		name:    data.name,
+	}
+}
+
+// AllCountries returns all countries that have at least one timezone, sorted by alpha-2 code.
+func AllCountries() []Country {
+	result := make([]Country, 0, len(countries))
+
+	for code, data := range countries {
+		result = append(result, newCountry(code, data))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].alpha2 < result[j].alpha2
+	})
+
+	return result
+}
+
+// Code returns the ISO 3166-1 alpha-2 country code.
+func (c Country) Code() string {
+	return c.alpha2
+}
+
+// Alpha3 returns the ISO 3166-1 alpha-3 country code.
+func (c Country) Alpha3() string {
+	return c.alpha3
+}
+
+// Numeric returns the three-digit ISO 3166-1 numeric country code, including leading zeros.
+func (c Country) Numeric() string {
+	return c.numeric
+}
+
+// Name returns the English short name of the country.
+func (c Country) Name() string {
+	return c.name
+}
+
+// LocalName returns the country name in the given language (for example "de" or "pt-BR").
+// Only the primary language subtag is considered. Falls back to the English name
+// when no localized name is available.
+func (c Country) LocalName(lang string) string {
+	lang, _, _ = strings.Cut(strings.ReplaceAll(lang, "_", "-"), "-")
+
+	if name, ok := countryNames[strings.ToLower(lang)][c.alpha2]; ok {
+		return name
+	}
+
+	return c.name
+}
+
+// Flag returns the country's flag as a pair of Unicode regional indicator symbols.
+// Returns an empty string for the zero Country.
+func (c Country) Flag() string {
+	if len(c.alpha2) != 2 {
+		return ""
+	}
+
+	const regionalIndicatorA = 0x1F1E6
+
+	return string([]rune{
+		regionalIndicatorA + rune(c.alpha2[0]-'A'),
+		regionalIndicatorA + rune(c.alpha2[1]-'A'),
+	})
+}
+
+// Timezones returns all timezones for the country, sorted by identifier.
+func (c Country) Timezones() []Timezone {
+	return ByCountryCode(c.alpha2)
+}
+
+// Country returns the country metadata for the timezone.
+// Reports false for timezones without a country, such as Etc/UTC.
+func (t Timezone) Country() (Country, bool) {
+	data, ok := countries[t.countryCode]
+	if !ok {
+		return Country{}, false
+	}
+
+	return newCountry(t.countryCode, data), true
+}
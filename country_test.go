@@ -0,0 +1,218 @@
+package tz
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestDecodeCountry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		code        string
+		wantAlpha2  string
+		wantAlpha3  string
+		wantNumeric string
+		wantName    string
+		wantErr     bool
+	}{
+		{name: "alpha-2", code: "DE", wantAlpha2: "DE", wantAlpha3: "DEU", wantNumeric: "276", wantName: "Germany"},
+		{name: "alpha-3", code: "NOR", wantAlpha2: "NO", wantAlpha3: "NOR", wantNumeric: "578", wantName: "Norway"},
+		{name: "leading zero numeric", code: "AF", wantAlpha2: "AF", wantAlpha3: "AFG", wantNumeric: "004", wantName: "Afghanistan"},
+		{name: "short name", code: "US", wantAlpha2: "US", wantAlpha3: "USA", wantNumeric: "840", wantName: "United States"},
+
+		// Error cases.
+		{name: "empty string", code: "", wantErr: true},
+		{name: "unknown code", code: "ZZ", wantErr: true},
+		{name: "wrong case", code: "de", wantErr: true},
+		{name: "unknown alpha-3", code: "ZZZ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := DecodeCountry(tt.code)
+
+			if tt.wantErr {
+				if !errors.Is(err, ErrCountryNotFound) {
+					t.Errorf("expected errors.Is(err, ErrCountryNotFound), got: %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if c.Code() != tt.wantAlpha2 {
+				t.Errorf("Code() = %q, want %q", c.Code(), tt.wantAlpha2)
+			}
+
+			if c.Alpha3() != tt.wantAlpha3 {
+				t.Errorf("Alpha3() = %q, want %q", c.Alpha3(), tt.wantAlpha3)
+			}
+
+			if c.Numeric() != tt.wantNumeric {
+				t.Errorf("Numeric() = %q, want %q", c.Numeric(), tt.wantNumeric)
+			}
+
+			if c.Name() != tt.wantName {
+				t.Errorf("Name() = %q, want %q", c.Name(), tt.wantName)
+			}
+		})
+	}
+}
+
+func TestCountryLocalName(t *testing.T) {
+	t.Parallel()
+
+	c, err := DecodeCountry("DE")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{"de", "Deutschland"},
+		{"de-CH", "Deutschland"},
+		{"fr_FR", "Allemagne"},
+		{"FR", "Allemagne"},
+		{"en", "Germany"},
+		{"", "Germany"},
+		{"xx", "Germany"},
+	}
+
+	for _, tt := range tests {
+		if got := c.LocalName(tt.lang); got != tt.want {
+			t.Errorf("LocalName(%q) = %q, want %q", tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestCountryFlag(t *testing.T) {
+	t.Parallel()
+
+	c, err := DecodeCountry("NO")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := c.Flag(); got != "\U0001F1F3\U0001F1F4" {
+		t.Errorf("Flag() = %q, want %q", got, "\U0001F1F3\U0001F1F4")
+	}
+
+	if got := (Country{}).Flag(); got != "" {
+		t.Errorf("Country{}.Flag() = %q, want empty", got)
+	}
+}
+
+func TestTimezoneCountry(t *testing.T) {
+	t.Parallel()
+
+	tz, err := Decode("Europe/Oslo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c, ok := tz.Country()
+	if !ok {
+		t.Fatal("Country() reported false for Europe/Oslo")
+	}
+
+	if c.Code() != "NO" || c.Alpha3() != "NOR" {
+		t.Errorf("Country() = %s/%s, want NO/NOR", c.Code(), c.Alpha3())
+	}
+
+	utc, err := Decode("Etc/UTC")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := utc.Country(); ok {
+		t.Error("Country() reported true for Etc/UTC")
+	}
+}
+
+func TestCountryDataIntegrity(t *testing.T) {
+	t.Parallel()
+
+	// Every country referenced by a timezone must have metadata.
+	for id, data := range timezones {
+		if data.countryCode == "" {
+			continue
+		}
+
+		if _, ok := countries[data.countryCode]; !ok {
+			t.Errorf("%s: country code %q has no country metadata", id, data.countryCode)
+		}
+	}
+
+	// Every country must have at least one timezone and well-formed codes.
+	for code, data := range countries {
+		if len(ByCountryCode(code)) == 0 {
+			t.Errorf("%s: country has no timezones", code)
+		}
+
+		if len(data.alpha3) != 3 {
+			t.Errorf("%s: alpha-3 code %q is not 3 characters", code, data.alpha3)
+		}
+
+		if len(data.numeric) != 3 {
+			t.Errorf("%s: numeric code %q is not 3 digits", code, data.numeric)
+		}
+
+		if data.name == "" {
+			t.Errorf("%s: empty name", code)
+		}
+	}
+
+	// Localized names must refer to known countries.
+	for lang, names := range countryNames {
+		for code := range names {
+			if _, ok := countries[code]; !ok {
+				t.Errorf("%s: localized name for unknown country %q", lang, code)
+			}
+		}
+	}
+}
+
+func TestAllCountries(t *testing.T) {
+	t.Parallel()
+
+	all := AllCountries()
+
+	if len(all) != len(countries) {
+		t.Errorf("AllCountries() returned %d entries, want %d", len(all), len(countries))
+	}
+
+	for i := 1; i < len(all); i++ {
+		if all[i].Code() <= all[i-1].Code() {
+			t.Errorf("AllCountries() not sorted: %q >= %q", all[i-1].Code(), all[i].Code())
+		}
+	}
+}
+
+// Examples.
+
+func ExampleDecodeCountry() {
+	c, err := DecodeCountry("NO")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(c.Name())
+	fmt.Println(c.Alpha3())
+	fmt.Println(c.Numeric())
+	fmt.Println(c.LocalName("de"))
+	// Output:
+	// Norway
+	// NOR
+	// 578
+	// Norwegen
+}
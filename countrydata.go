@@ -0,0 +1,2697 @@
+package tz
+
+// countryData holds the ISO 3166-1 metadata for a country code.
+type countryData struct {
+	alpha3  string
+	numeric string
+	name    string
+}
+
+// countries maps ISO 3166-1 alpha-2 codes to their alpha-3 code, numeric code and English short name.
+// Only countries referenced by at least one timezone are listed.
+//
+//nolint:maintidx // Large data map is expected.
+var countries = map[string]countryData{
+	"AD": {"AND", "020", "Andorra"},
+	"AE": {"ARE", "784", "United Arab Emirates"},
+	"AF": {"AFG", "004", "Afghanistan"},
+	"AG": {"ATG", "028", "Antigua and Barbuda"},
+	"AI": {"AIA", "660", "Anguilla"},
+	"AL": {"ALB", "008", "Albania"},
+	"AM": {"ARM", "051", "Armenia"},
+	"AO": {"AGO", "024", "Angola"},
+	"AQ": {"ATA", "010", "Antarctica"},
+	"AR": {"ARG", "032", "Argentina"},
+	"AS": {"ASM", "016", "American Samoa"},
+	"AT": {"AUT", "040", "Austria"},
+	"AU": {"AUS", "036", "Australia"},
+	"AW": {"ABW", "533", "Aruba"},
+	"AX": {"ALA", "248", "Åland Islands"},
+	"AZ": {"AZE", "031", "Azerbaijan"},
+	"BA": {"BIH", "070", "Bosnia and Herzegovina"},
+	"BB": {"BRB", "052", "Barbados"},
+	"BD": {"BGD", "050", "Bangladesh"},
+	"BE": {"BEL", "056", "Belgium"},
+	"BF": {"BFA", "854", "Burkina Faso"},
+	"BG": {"BGR", "100", "Bulgaria"},
+	"BH": {"BHR", "048", "Bahrain"},
+	"BI": {"BDI", "108", "Burundi"},
+	"BJ": {"BEN", "204", "Benin"},
+	"BL": {"BLM", "652", "St. Barthélemy"},
+	"BM": {"BMU", "060", "Bermuda"},
+	"BN": {"BRN", "096", "Brunei"},
+	"BO": {"BOL", "068", "Bolivia"},
+	"BQ": {"BES", "535", "Caribbean Netherlands"},
+	"BR": {"BRA", "076", "Brazil"},
+	"BS": {"BHS", "044", "Bahamas"},
+	"BT": {"BTN", "064", "Bhutan"},
+	"BW": {"BWA", "072", "Botswana"},
+	"BY": {"BLR", "112", "Belarus"},
+	"BZ": {"BLZ", "084", "Belize"},
+	"CA": {"CAN", "124", "Canada"},
+	"CC": {"CCK", "166", "Cocos (Keeling) Islands"},
+	"CD": {"COD", "180", "Congo - Kinshasa"},
+	"CF": {"CAF", "140", "Central African Republic"},
+	"CG": {"COG", "178", "Congo - Brazzaville"},
+	"CH": {"CHE", "756", "Switzerland"},
+	"CI": {"CIV", "384", "Côte d'Ivoire"},
+	"CK": {"COK", "184", "Cook Islands"},
+	"CL": {"CHL", "152", "Chile"},
+	"CM": {"CMR", "120", "Cameroon"},
+	"CN": {"CHN", "156", "China"},
+	"CO": {"COL", "170", "Colombia"},
+	"CR": {"CRI", "188", "Costa Rica"},
+	"CU": {"CUB", "192", "Cuba"},
+	"CV": {"CPV", "132", "Cape Verde"},
+	"CW": {"CUW", "531", "Curaçao"},
+	"CX": {"CXR", "162", "Christmas Island"},
+	"CY": {"CYP", "196", "Cyprus"},
+	"CZ": {"CZE", "203", "Czechia"},
+	"DE": {"DEU", "276", "Germany"},
+	"DJ": {"DJI", "262", "Djibouti"},
+	"DK": {"DNK", "208", "Denmark"},
+	"DM": {"DMA", "212", "Dominica"},
+	"DO": {"DOM", "214", "Dominican Republic"},
+	"DZ": {"DZA", "012", "Algeria"},
+	"EC": {"ECU", "218", "Ecuador"},
+	"EE": {"EST", "233", "Estonia"},
+	"EG": {"EGY", "818", "Egypt"},
+	"EH": {"ESH", "732", "Western Sahara"},
+	"ER": {"ERI", "232", "Eritrea"},
+	"ES": {"ESP", "724", "Spain"},
+	"ET": {"ETH", "231", "Ethiopia"},
+	"FI": {"FIN", "246", "Finland"},
+	"FJ": {"FJI", "242", "Fiji"},
+	"FK": {"FLK", "238", "Falkland Islands"},
+	"FM": {"FSM", "583", "Micronesia"},
+	"FO": {"FRO", "234", "Faroe Islands"},
+	"FR": {"FRA", "250", "France"},
+	"GA": {"GAB", "266", "Gabon"},
+	"GB": {"GBR", "826", "United Kingdom"},
+	"GD": {"GRD", "308", "Grenada"},
+	"GE": {"GEO", "268", "Georgia"},
+	"GF": {"GUF", "254", "French Guiana"},
+	"GG": {"GGY", "831", "Guernsey"},
+	"GH": {"GHA", "288", "Ghana"},
+	"GI": {"GIB", "292", "Gibraltar"},
+	"GL": {"GRL", "304", "Greenland"},
+	"GM": {"GMB", "270", "Gambia"},
+	"GN": {"GIN", "324", "Guinea"},
+	"GP": {"GLP", "312", "Guadeloupe"},
+	"GQ": {"GNQ", "226", "Equatorial Guinea"},
+	"GR": {"GRC", "300", "Greece"},
+	"GS": {"SGS", "239", "South Georgia and the South Sandwich Islands"},
+	"GT": {"GTM", "320", "Guatemala"},
+	"GU": {"GUM", "316", "Guam"},
+	"GW": {"GNB", "624", "Guinea-Bissau"},
+	"GY": {"GUY", "328", "Guyana"},
+	"HK": {"HKG", "344", "Hong Kong SAR China"},
+	"HN": {"HND", "340", "Honduras"},
+	"HR": {"HRV", "191", "Croatia"},
+	"HT": {"HTI", "332", "Haiti"},
+	"HU": {"HUN", "348", "Hungary"},
+	"ID": {"IDN", "360", "Indonesia"},
+	"IE": {"IRL", "372", "Ireland"},
+	"IL": {"ISR", "376", "Israel"},
+	"IM": {"IMN", "833", "Isle of Man"},
+	"IN": {"IND", "356", "India"},
+	"IO": {"IOT", "086", "British Indian Ocean Territory"},
+	"IQ": {"IRQ", "368", "Iraq"},
+	"IR": {"IRN", "364", "Iran"},
+	"IS": {"ISL", "352", "Iceland"},
+	"IT": {"ITA", "380", "Italy"},
+	"JE": {"JEY", "832", "Jersey"},
+	"JM": {"JAM", "388", "Jamaica"},
+	"JO": {"JOR", "400", "Jordan"},
+	"JP": {"JPN", "392", "Japan"},
+	"KE": {"KEN", "404", "Kenya"},
+	"KG": {"KGZ", "417", "Kyrgyzstan"},
+	"KH": {"KHM", "116", "Cambodia"},
+	"KI": {"KIR", "296", "Kiribati"},
+	"KM": {"COM", "174", "Comoros"},
+	"KN": {"KNA", "659", "St. Kitts & Nevis"},
+	"KP": {"PRK", "408", "North Korea"},
+	"KR": {"KOR", "410", "South Korea"},
+	"KW": {"KWT", "414", "Kuwait"},
+	"KY": {"CYM", "136", "Cayman Islands"},
+	"KZ": {"KAZ", "398", "Kazakhstan"},
+	"LA": {"LAO", "418", "Laos"},
+	"LB": {"LBN", "422", "Lebanon"},
+	"LC": {"LCA", "662", "St. Lucia"},
+	"LI": {"LIE", "438", "Liechtenstein"},
+	"LK": {"LKA", "144", "Sri Lanka"},
+	"LR": {"LBR", "430", "Liberia"},
+	"LS": {"LSO", "426", "Lesotho"},
+	"LT": {"LTU", "440", "Lithuania"},
+	"LU": {"LUX", "442", "Luxembourg"},
+	"LV": {"LVA", "428", "Latvia"},
+	"LY": {"LBY", "434", "Libya"},
+	"MA": {"MAR", "504", "Morocco"},
+	"MC": {"MCO", "492", "Monaco"},
+	"MD": {"MDA", "498", "Moldova"},
+	"ME": {"MNE", "499", "Montenegro"},
+	"MF": {"MAF", "663", "St. Martin"},
+	"MG": {"MDG", "450", "Madagascar"},
+	"MH": {"MHL", "584", "Marshall Islands"},
+	"MK": {"MKD", "807", "North Macedonia"},
+	"ML": {"MLI", "466", "Mali"},
+	"MM": {"MMR", "104", "Myanmar (Burma)"},
+	"MN": {"MNG", "496", "Mongolia"},
+	"MO": {"MAC", "446", "Macao SAR China"},
+	"MQ": {"MTQ", "474", "Martinique"},
+	"MR": {"MRT", "478", "Mauritania"},
+	"MS": {"MSR", "500", "Montserrat"},
+	"MT": {"MLT", "470", "Malta"},
+	"MU": {"MUS", "480", "Mauritius"},
+	"MV": {"MDV", "462", "Maldives"},
+	"MW": {"MWI", "454", "Malawi"},
+	"MX": {"MEX", "484", "Mexico"},
+	"MY": {"MYS", "458", "Malaysia"},
+	"MZ": {"MOZ", "508", "Mozambique"},
+	"NA": {"NAM", "516", "Namibia"},
+	"NC": {"NCL", "540", "New Caledonia"},
+	"NE": {"NER", "562", "Niger"},
+	"NF": {"NFK", "574", "Norfolk Island"},
+	"NG": {"NGA", "566", "Nigeria"},
+	"NI": {"NIC", "558", "Nicaragua"},
+	"NL": {"NLD", "528", "Netherlands"},
+	"NO": {"NOR", "578", "Norway"},
+	"NP": {"NPL", "524", "Nepal"},
+	"NR": {"NRU", "520", "Nauru"},
+	"NU": {"NIU", "570", "Niue"},
+	"NZ": {"NZL", "554", "New Zealand"},
+	"OM": {"OMN", "512", "Oman"},
+	"PA": {"PAN", "591", "Panama"},
+	"PE": {"PER", "604", "Peru"},
+	"PF": {"PYF", "258", "French Polynesia"},
+	"PG": {"PNG", "598", "Papua New Guinea"},
+	"PH": {"PHL", "608", "Philippines"},
+	"PK": {"PAK", "586", "Pakistan"},
+	"PL": {"POL", "616", "Poland"},
+	"PM": {"SPM", "666", "St. Pierre & Miquelon"},
+	"PN": {"PCN", "612", "Pitcairn"},
+	"PR": {"PRI", "630", "Puerto Rico"},
+	"PS": {"PSE", "275", "Palestinian Territories"},
+	"PT": {"PRT", "620", "Portugal"},
+	"PW": {"PLW", "585", "Palau"},
+	"PY": {"PRY", "600", "Paraguay"},
+	"QA": {"QAT", "634", "Qatar"},
+	"RE": {"REU", "638", "Réunion"},
+	"RO": {"ROU", "642", "Romania"},
+	"RS": {"SRB", "688", "Serbia"},
+	"RU": {"RUS", "643", "Russia"},
+	"RW": {"RWA", "646", "Rwanda"},
+	"SA": {"SAU", "682", "Saudi Arabia"},
+	"SB": {"SLB", "090", "Solomon Islands"},
+	"SC": {"SYC", "690", "Seychelles"},
+	"SD": {"SDN", "729", "Sudan"},
+	"SE": {"SWE", "752", "Sweden"},
+	"SG": {"SGP", "702", "Singapore"},
+	"SH": {"SHN", "654", "St. Helena"},
+	"SI": {"SVN", "705", "Slovenia"},
+	"SK": {"SVK", "703", "Slovakia"},
+	"SL": {"SLE", "694", "Sierra Leone"},
+	"SM": {"SMR", "674", "San Marino"},
+	"SN": {"SEN", "686", "Senegal"},
+	"SO": {"SOM", "706", "Somalia"},
+	"SR": {"SUR", "740", "Suriname"},
+	"SS": {"SSD", "728", "South Sudan"},
+	"ST": {"STP", "678", "Sao Tome and Principe"},
+	"SV": {"SLV", "222", "El Salvador"},
+	"SX": {"SXM", "534", "Sint Maarten"},
+	"SY": {"SYR", "760", "Syria"},
+	"SZ": {"SWZ", "748", "Eswatini"},
+	"TC": {"TCA", "796", "Turks and Caicos Islands"},
+	"TD": {"TCD", "148", "Chad"},
+	"TF": {"ATF", "260", "French Southern Territories"},
+	"TG": {"TGO", "768", "Togo"},
+	"TH": {"THA", "764", "Thailand"},
+	"TJ": {"TJK", "762", "Tajikistan"},
+	"TK": {"TKL", "772", "Tokelau"},
+	"TL": {"TLS", "626", "Timor-Leste"},
+	"TM": {"TKM", "795", "Turkmenistan"},
+	"TN": {"TUN", "788", "Tunisia"},
+	"TO": {"TON", "776", "Tonga"},
+	"TR": {"TUR", "792", "Türkiye"},
+	"TT": {"TTO", "780", "Trinidad and Tobago"},
+	"TV": {"TUV", "798", "Tuvalu"},
+	"TW": {"TWN", "158", "Taiwan"},
+	"TZ": {"TZA", "834", "Tanzania"},
+	"UA": {"UKR", "804", "Ukraine"},
+	"UG": {"UGA", "800", "Uganda"},
+	"UM": {"UMI", "581", "U.S. Outlying Islands"},
+	"US": {"USA", "840", "United States"},
+	"UY": {"URY", "858", "Uruguay"},
+	"UZ": {"UZB", "860", "Uzbekistan"},
+	"VA": {"VAT", "336", "Vatican City"},
+	"VC": {"VCT", "670", "St. Vincent & Grenadines"},
+	"VE": {"VEN", "862", "Venezuela"},
+	"VG": {"VGB", "092", "British Virgin Islands"},
+	"VI": {"VIR", "850", "U.S. Virgin Islands"},
+	"VN": {"VNM", "704", "Vietnam"},
+	"VU": {"VUT", "548", "Vanuatu"},
+	"WF": {"WLF", "876", "Wallis and Futuna"},
+	"WS": {"WSM", "882", "Samoa"},
+	"YE": {"YEM", "887", "Yemen"},
+	"YT": {"MYT", "175", "Mayotte"},
+	"ZA": {"ZAF", "710", "South Africa"},
+	"ZM": {"ZMB", "894", "Zambia"},
+	"ZW": {"ZWE", "716", "Zimbabwe"},
+}
+
+// countryNames maps a language tag to localized country names keyed by alpha-2 code.
+// Entries identical to the English name are omitted.
+//
+//nolint:maintidx // Large data map is expected.
+var countryNames = map[string]map[string]string{
+	"de": {
+		"AE": "Vereinigte Arabische Emirate",
+		"AG": "Antigua und Barbuda",
+		"AL": "Albanien",
+		"AM": "Armenien",
+		"AQ": "Antarktis",
+		"AR": "Argentinien",
+		"AS": "Amerikanisch-Samoa",
+		"AT": "Österreich",
+		"AU": "Australien",
+		"AX": "Åland-Inseln",
+		"AZ": "Aserbaidschan",
+		"BA": "Bosnien und Herzegowina",
+		"BD": "Bangladesch",
+		"BE": "Belgien",
+		"BG": "Bulgarien",
+		"BL": "Saint-Barthélemy",
+		"BO": "Bolivien",
+		"BQ": "Bonaire, Sint Eustatius und Saba",
+		"BR": "Brasilien",
+		"BW": "Botsuana",
+		"CA": "Kanada",
+		"CC": "Kokos-(Keeling-)Inseln",
+		"CD": "Demokratische Republik Kongo",
+		"CF": "Zentralafrikanische Republik",
+		"CG": "Kongo",
+		"CH": "Schweiz",
+		"CK": "Cookinseln",
+		"CM": "Kamerun",
+		"CO": "Kolumbien",
+		"CU": "Kuba",
+		"CV": "Kap Verde",
+		"CX": "Weihnachtsinseln",
+		"CY": "Zypern",
+		"CZ": "Tschechien",
+		"DE": "Deutschland",
+		"DJ": "Dschibuti",
+		"DK": "Dänemark",
+		"DO": "Dominikanische Republik",
+		"DZ": "Algerien",
+		"EE": "Estland",
+		"EG": "Ägypten",
+		"EH": "Westsahara",
+		"ES": "Spanien",
+		"ET": "Äthiopien",
+		"FI": "Finnland",
+		"FJ": "Fidschi",
+		"FK": "Falklandinseln (Malwinen)",
+		"FM": "Mikronesien, Föderierte Staaten von",
+		"FO": "Färöer-Inseln",
+		"FR": "Frankreich",
+		"GA": "Gabun",
+		"GB": "Vereinigtes Königreich",
+		"GE": "Georgien",
+		"GF": "Französisch-Guyana",
+		"GL": "Grönland",
+		"GQ": "Äquatorialguinea",
+		"GR": "Griechenland",
+		"GS": "South Georgia und die Südlichen Sandwichinseln",
+		"HK": "Hongkong",
+		"HR": "Kroatien",
+		"HU": "Ungarn",
+		"ID": "Indonesien",
+		"IE": "Irland",
+		"IM": "Insel Man",
+		"IN": "Indien",
+		"IO": "Britisches Territorium im Indischen Ozean",
+		"IQ": "Irak",
+		"IR": "Iran, Islamische Republik",
+		"IS": "Island",
+		"IT": "Italien",
+		"JM": "Jamaika",
+		"JO": "Jordanien",
+		"KE": "Kenia",
+		"KG": "Kirgisistan",
+		"KH": "Kambodscha",
+		"KM": "Komoren",
+		"KN": "St. Kitts und Nevis",
+		"KP": "Nordkorea",
+		"KR": "Südkorea",
+		"KY": "Cayman-Inseln",
+		"KZ": "Kasachstan",
+		"LA": "Laos, Demokratische Volksrepublik",
+		"LB": "Libanon",
+		"LT": "Litauen",
+		"LU": "Luxemburg",
+		"LV": "Lettland",
+		"LY": "Libyen",
+		"MA": "Marokko",
+		"MD": "Moldau",
+		"MF": "Saint Martin (Französischer Teil)",
+		"MG": "Madagaskar",
+		"MH": "Marshallinseln",
+		"MK": "Nordmazedonien",
+		"MN": "Mongolei",
+		"MR": "Mauretanien",
+		"MV": "Malediven",
+		"MX": "Mexiko",
+		"MZ": "Mosambik",
+		"NC": "Neukaledonien",
+		"NF": "Norfolkinsel",
+		"NL": "Niederlande",
+		"NO": "Norwegen",
+		"NZ": "Neuseeland",
+		"PF": "Französisch-Polynesien",
+		"PG": "Papua-Neuguinea",
+		"PH": "Philippinen",
+		"PL": "Polen",
+		"PM": "St. Pierre und Miquelon",
+		"PS": "Palästina, Staat",
+		"QA": "Katar",
+		"RO": "Rumänien",
+		"RS": "Serbien",
+		"RU": "Russische Föderation",
+		"RW": "Ruanda",
+		"SA": "Saudi-Arabien",
+		"SB": "Salomoninseln",
+		"SC": "Seychellen",
+		"SE": "Schweden",
+		"SG": "Singapur",
+		"SH": "St. Helena, Ascension und Tristan da Cunha",
+		"SI": "Slowenien",
+		"SK": "Slowakei",
+		"SS": "Südsudan",
+		"ST": "São Tomé und Príncipe",
+		"SX": "Saint-Martin (Niederländischer Teil)",
+		"SY": "Syrien",
+		"TC": "Turks- und Caicosinseln",
+		"TD": "Tschad",
+		"TF": "Französische Süd- und Antarktisgebiete",
+		"TJ": "Tadschikistan",
+		"TN": "Tunesien",
+		"TR": "Türkei",
+		"TT": "Trinidad und Tobago",
+		"TW": "Taiwan, Chinesische Provinz",
+		"TZ": "Tansania",
+		"US": "Vereinigte Staaten",
+		"UZ": "Usbekistan",
+		"VA": "Heiliger Stuhl (Staat Vatikanstadt)",
+		"VC": "St. Vincent und die Grenadinen",
+		"VE": "Venezuela, Bolivarische Republik",
+		"VG": "Britische Jungferninseln",
+		"VI": "Amerikanische Jungferninseln",
+		"WF": "Wallis und Futuna",
+		"YE": "Jemen",
+		"ZA": "Südafrika",
+		"ZM": "Sambia",
+		"ZW": "Simbabwe",
+	},
+	"es": {
+		"AE": "Emiratos Árabes Unidos",
+		"AF": "Afganistán",
+		"AG": "Antigua y Barbuda",
+		"AI": "Anguila",
+		"AQ": "Antártida",
+		"AS": "Samoa Estadounidense",
+		"AX": "Islas Äland",
+		"AZ": "Azerbaiyán",
+		"BA": "Bosnia y Herzegovina",
+		"BD": "Bangladés",
+		"BE": "Bélgica",
+		"BF": "Burquina Faso",
+		"BH": "Baréin",
+		"BJ": "Benín",
+		"BL": "San Bartolomé",
+		"BM": "Islas Bermudas",
+		"BO": "Bolivia, Estado plurinacional de",
+		"BQ": "Islas BES (Caribe Neerlandés)",
+		"BR": "Brasil",
+		"BT": "Bután",
+		"BW": "Botsuana",
+		"BY": "Bielorrusia",
+		"BZ": "Belice",
+		"CA": "Canadá",
+		"CC": "Islas Cocos (Keeling)",
+		"CD": "Congo, República Democrática del",
+		"CF": "República Centroafricana",
+		"CH": "Suiza",
+		"CI": "Costa de Marfíl",
+		"CK": "Islas Cook",
+		"CM": "Camerún",
+		"CW": "Curazao",
+		"CX": "Isla de Navidad",
+		"CY": "Chipre",
+		"CZ": "Chequia",
+		"DE": "Alemania",
+		"DJ": "Yibuti",
+		"DK": "Dinamarca",
+		"DO": "República Dominicana",
+		"EG": "Egipto",
+		"EH": "Sahara Occidental",
+		"ES": "España",
+		"ET": "Etiopía",
+		"FI": "Finlandia",
+		"FJ": "Fiyi",
+		"FK": "Islas Falkland (Malvinas)",
+		"FM": "Micronesia, Estados Federados de",
+		"FO": "Islas Feroe",
+		"FR": "Francia",
+		"GA": "Gabón",
+		"GB": "Reino Unido",
+		"GD": "Granada",
+		"GF": "Guayana Francesa",
+		"GL": "Groenlandia",
+		"GP": "Guadalupe",
+		"GQ": "Guinea Ecuatorial",
+		"GR": "Grecia",
+		"GS": "Islas Georgias del Sur y Sándwich del Sur",
+		"GW": "Guinea-Bisáu",
+		"HR": "Croacia",
+		"HT": "Haití",
+		"HU": "Hungría",
+		"IE": "Irlanda",
+		"IM": "Isla de Man",
+		"IO": "Territorio Británico del Océano Índico",
+		"IQ": "Irak",
+		"IR": "Irán, República islámica de",
+		"IS": "Islandia",
+		"IT": "Italia",
+		"JO": "Jordania",
+		"JP": "Japón",
+		"KE": "Kenia",
+		"KG": "Kirguistán",
+		"KH": "Camboya",
+		"KM": "Comores, Islas",
+		"KN": "San Cristóbal y Nieves",
+		"KP": "Corea, República Democrática Popular de",
+		"KR": "Corea, República de",
+		"KY": "Islas Caimán",
+		"KZ": "Kazajistán",
+		"LA": "República Democrática Popular de Lao",
+		"LB": "Líbano",
+		"LC": "Santa Lucía",
+		"LS": "Lesoto",
+		"LT": "Lituania",
+		"LU": "Luxemburgo",
+		"LV": "Letonia",
+		"LY": "Libia",
+		"MA": "Marruecos",
+		"MC": "Mónaco",
+		"MD": "Moldavia",
+		"MF": "San Martín (zona francesa)",
+		"MH": "Islas Marshall",
+		"MK": "Macedonia del Norte",
+		"ML": "Malí",
+		"MM": "Birmania",
+		"MQ": "Martinica",
+		"MU": "Mauricio",
+		"MV": "Islas Maldivas",
+		"MW": "Malaui",
+		"MX": "México",
+		"MY": "Malasia",
+		"NC": "Nueva Caledonia",
+		"NF": "Isla Norfolk",
+		"NL": "Países Bajos",
+		"NO": "Noruega",
+		"NZ": "Nueva Zelanda",
+		"OM": "Omán",
+		"PA": "Panamá",
+		"PE": "Perú",
+		"PF": "Polinesia Francesa",
+		"PG": "Papúa Nueva Guinea",
+		"PH": "Filipinas",
+		"PK": "Pakistán",
+		"PL": "Polonia",
+		"PM": "San Pedro y Miquelon",
+		"PS": "Palestina, Estado de",
+		"PW": "Palaos",
+		"QA": "Catar",
+		"RE": "Reunión",
+		"RO": "Rumanía",
+		"RU": "Federación Rusa",
+		"RW": "Ruanda",
+		"SA": "Arabia Saudí",
+		"SB": "Islas Salomón",
+		"SD": "Sudán",
+		"SE": "Suecia",
+		"SG": "Singapur",
+		"SH": "Santa Elena, Ascensión y Tristán de Acuña",
+		"SI": "Eslovenia",
+		"SK": "Eslovaquia",
+		"SL": "Sierra Leona",
+		"SR": "Surinám",
+		"SS": "Sudán del Sur",
+		"ST": "Santo Tomé y Príncipe",
+		"SX": "Isla de San Martín (zona holandsea)",
+		"SY": "República árabe de Siria",
+		"SZ": "Esuatini",
+		"TC": "Islas Turcas y Caicos",
+		"TF": "Territorios Franceses del Sur",
+		"TH": "Tailandia",
+		"TJ": "Tayikistán",
+		"TL": "Timor Oriental",
+		"TM": "Turkmenistán",
+		"TN": "Tunez",
+		"TT": "Trinidad y Tobago",
+		"TW": "Taiwán",
+		"TZ": "Tanzania, República unida de",
+		"UA": "Ucrania",
+		"UM": "Islas Ultramarinas Menores de Estados Unidos",
+		"US": "Estados Unidos",
+		"UZ": "Uzbekistán",
+		"VA": "Santa Sede (Ciudad Estado del Vaticano)",
+		"VC": "San Vicente y las Granadinas",
+		"VE": "Venezuela, República Bolivariana de",
+		"VG": "Islas Vírgenes, Británicas",
+		"VI": "Islas Vírgenes, de EEUU",
+		"WF": "Wallis y Futuna",
+		"ZA": "Sudáfrica",
+		"ZW": "Zimbabue",
+	},
+	"fr": {
+		"AD": "Andorre",
+		"AE": "Émirats arabes unis",
+		"AG": "Antigua-et-Barbuda",
+		"AL": "Albanie",
+		"AM": "Arménie",
+		"AQ": "Antarctique",
+		"AR": "Argentine",
+		"AS": "Samoa américaines",
+		"AT": "Autriche",
+		"AU": "Australie",
+		"AX": "Åland, Îles",
+		"AZ": "Azerbaïdjan",
+		"BA": "Bosnie-Herzégovine",
+		"BB": "Barbade",
+		"BE": "Belgique",
+		"BG": "Bulgarie",
+		"BH": "Bahreïn",
+		"BJ": "Bénin",
+		"BL": "Saint-Barthélemy",
+		"BM": "Bermudes",
+		"BN": "Brunéi Darussalam",
+		"BO": "Bolivie",
+		"BQ": "Bonaire, Saint-Eustache et Saba",
+		"BR": "Brésil",
+		"BT": "Bhoutan",
+		"BY": "Bélarus",
+		"CC": "Cocos (Keeling), Îles",
+		"CD": "République démocratique du Congo",
+		"CF": "République centrafricaine",
+		"CG": "République du Congo",
+		"CH": "Suisse",
+		"CK": "îles Cook",
+		"CL": "Chili",
+		"CM": "Cameroun",
+		"CN": "Chine",
+		"CO": "Colombie",
+		"CV": "Cap-Vert",
+		"CX": "Christmas, Île",
+		"CY": "Chypre",
+		"CZ": "Tchéquie",
+		"DE": "Allemagne",
+		"DK": "Danemark",
+		"DM": "Dominique",
+		"DO": "République dominicaine",
+		"DZ": "Algérie",
+		"EC": "Équateur",
+		"EE": "Estonie",
+		"EG": "Égypte",
+		"EH": "Sahara occidental",
+		"ER": "Érythrée",
+		"ES": "Espagne",
+		"ET": "Éthiopie",
+		"FI": "Finlande",
+		"FJ": "Fidji",
+		"FK": "Malouines, Îles (Falkland)",
+		"FM": "Micronésie, États fédérés de",
+		"FO": "îles Féroé",
+		"GB": "Royaume-Uni",
+		"GD": "Grenade",
+		"GE": "Géorgie",
+		"GF": "Guyane française",
+		"GG": "Guernesey",
+		"GL": "Groënland",
+		"GM": "Gambie",
+		"GN": "Guinée",
+		"GQ": "Guinée Équatoriale",
+		"GR": "Grèce",
+		"GS": "Géorgie du Sud et les îles Sandwich du Sud",
+		"GW": "Guinée-Bissau",
+		"HR": "Croatie",
+		"HT": "Haïti",
+		"HU": "Hongrie",
+		"ID": "Indonésie",
+		"IE": "Irlande",
+		"IL": "Israël",
+		"IM": "Île de Man",
+		"IN": "Inde",
+		"IO": "Territoire britannique de l'océan Indien",
+		"IQ": "Irak",
+		"IR": "Iran, République islamique d'",
+		"IS": "Islande",
+		"IT": "Italie",
+		"JM": "Jamaïque",
+		"JO": "Jordanie",
+		"JP": "Japon",
+		"KG": "Kirghizistan",
+		"KH": "Cambodge",
+		"KM": "Comores",
+		"KN": "Saint-Christophe-et-Niévès",
+		"KP": "Corée du Nord",
+		"KR": "Corée du Sud",
+		"KW": "Koweït",
+		"KY": "îles Caïmans",
+		"LA": "Lao, République démocratique populaire",
+		"LB": "Liban",
+		"LC": "Sainte-Lucie",
+		"LR": "Libéria",
+		"LT": "Lituanie",
+		"LV": "Lettonie",
+		"LY": "Libye",
+		"MA": "Maroc",
+		"MD": "Moldavie",
+		"ME": "Monténégro",
+		"MF": "Saint-Martin (partie française)",
+		"MH": "Îles Marshall",
+		"MK": "Macédoine du Nord",
+		"MM": "Birmanie",
+		"MN": "Mongolie",
+		"MO": "Macau",
+		"MR": "Mauritanie",
+		"MT": "Malte",
+		"MU": "Maurice",
+		"MX": "Mexique",
+		"MY": "Malaisie",
+		"NA": "Namibie",
+		"NC": "Nouvelle-Calédonie",
+		"NF": "île Norfolk",
+		"NL": "Pays-Bas",
+		"NO": "Norvège",
+		"NP": "Népal",
+		"NU": "Nioue",
+		"NZ": "Nouvelle-Zélande",
+		"PE": "Pérou",
+		"PF": "Polynésie française",
+		"PG": "Papouasie-Nouvelle-Guinée",
+		"PL": "Pologne",
+		"PM": "Saint-Pierre-et-Miquelon",
+		"PN": "Îles Pitcairn",
+		"PR": "Porto Rico",
+		"PS": "Palestine, État de",
+		"PW": "Palaos",
+		"RE": "Réunion, Île de la",
+		"RO": "Roumanie",
+		"RS": "Serbie",
+		"RU": "Russie, Fédération de",
+		"SA": "Arabie saoudite",
+		"SB": "Salomon, Îles",
+		"SD": "Soudan",
+		"SE": "Suède",
+		"SG": "Singapour",
+		"SH": "Sainte-Hélène, Ascension et Tristan da Cunha",
+		"SI": "Slovénie",
+		"SK": "Slovaquie",
+		"SM": "Saint-Marin",
+		"SN": "Sénégal",
+		"SO": "Somalie",
+		"SR": "Surinam",
+		"SS": "Soudan du Sud",
+		"ST": "Sao Tomé-et-Principe",
+		"SV": "Salvador",
+		"SX": "Saint-Martin (partie néerlandaise)",
+		"SY": "Syrienne, République arabe",
+		"TC": "îles Turques-et-Caïques",
+		"TD": "Tchad",
+		"TF": "Terres australes françaises",
+		"TH": "Thaïlande",
+		"TJ": "Tadjikistan",
+		"TL": "Timor oriental",
+		"TM": "Turkménistan",
+		"TN": "Tunisie",
+		"TT": "Trinité-et-Tobago",
+		"TW": "Taïwan",
+		"TZ": "Tanzanie",
+		"UG": "Ouganda",
+		"UM": "Îles mineures éloignées des États-Unis",
+		"US": "États-Unis",
+		"UZ": "Ouzbékistan",
+		"VA": "Saint-Siège (état de la cité du Vatican)",
+		"VC": "Saint-Vincent-et-les-Grenadines",
+		"VE": "Vénézuela",
+		"VG": "Îles Vierges britanniques",
+		"VI": "Îles Vierges, États-Unis",
+		"VN": "Viêt Nam",
+		"WF": "Wallis et Futuna",
+		"YE": "Yémen",
+		"ZA": "Afrique du Sud",
+		"ZM": "Zambie",
+	},
+	"it": {
+		"AE": "Emirati Arabi Uniti",
+		"AG": "Antigua e Barbuda",
+		"AQ": "Antartide",
+		"AS": "Samoa americane",
+		"AX": "Isole Åland",
+		"AZ": "Azerbaigian",
+		"BA": "Bosnia-Erzegovina",
+		"BE": "Belgio",
+		"BH": "Bahrein",
+		"BL": "Saint-Barthélemy",
+		"BO": "Bolivia, Stato Plurinazionale della",
+		"BQ": "Paesi Bassi caraibici",
+		"BR": "Brasile",
+		"BY": "Bielorussia",
+		"CC": "Isole Cocos (Keeling)",
+		"CD": "Repubblica democratica del Congo",
+		"CF": "Repubblica Centrafricana",
+		"CH": "Svizzera",
+		"CI": "Costa d'Avorio",
+		"CK": "Isole Cook",
+		"CL": "Cile",
+		"CM": "Camerun",
+		"CN": "Cina",
+		"CV": "Capo Verde",
+		"CX": "Isola di Natale",
+		"CY": "Cipro",
+		"CZ": "Cechia",
+		"DE": "Germania",
+		"DJ": "Gibuti",
+		"DK": "Danimarca",
+		"DO": "Repubblica Dominicana",
+		"EG": "Egitto",
+		"EH": "Sahara occidentale",
+		"ES": "Spagna",
+		"ET": "Etiopia",
+		"FI": "Finlandia",
+		"FJ": "Figi",
+		"FK": "Isole Falkland (Malvine)",
+		"FO": "Isole Fær Øer",
+		"FR": "Francia",
+		"GB": "Regno Unito",
+		"GF": "Guyana francese",
+		"GI": "Gibilterra",
+		"GL": "Groenlandia",
+		"GP": "Guadalupa",
+		"GQ": "Guinea equatoriale",
+		"GR": "Grecia",
+		"GS": "Georgia del Sud e Isole Sandwich Australi",
+		"HR": "Croazia",
+		"HU": "Ungheria",
+		"IE": "Irlanda",
+		"IL": "Israele",
+		"IM": "Isola di Man",
+		"IO": "Territorio britannico dell'Oceano Indiano",
+		"IS": "Islanda",
+		"IT": "Italia",
+		"JM": "Giamaica",
+		"JO": "Giordania",
+		"JP": "Giappone",
+		"KG": "Kirghizistan",
+		"KH": "Cambogia",
+		"KM": "Comore",
+		"KN": "Saint Kitts e Nevis",
+		"KP": "Corea del Nord",
+		"KR": "Corea del Sud",
+		"KY": "Isole Cayman",
+		"KZ": "Kazakistan",
+		"LB": "Libano",
+		"LT": "Lituania",
+		"LU": "Lussemburgo",
+		"LV": "Lettonia",
+		"LY": "Libia",
+		"MA": "Marocco",
+		"MD": "Moldavia",
+		"MF": "Saint-Martin (Francia)",
+		"MH": "Isole Marshall",
+		"MK": "Macedonia del Nord",
+		"MM": "Birmania",
+		"MQ": "Martinica",
+		"MU": "Maurizio",
+		"MV": "Maldive",
+		"MX": "Messico",
+		"MZ": "Mozambico",
+		"NC": "Nuova Caledonia",
+		"NF": "Isola Norfolk",
+		"NL": "Paesi Bassi",
+		"NO": "Norvegia",
+		"NZ": "Nuova Zelanda",
+		"PE": "Perù",
+		"PF": "Polinesia francese",
+		"PG": "Papua Nuova Guinea",
+		"PH": "Filippine",
+		"PL": "Polonia",
+		"PM": "Saint-Pierre e Miquelon",
+		"PR": "Portorico",
+		"PS": "Palestina, Stato di",
+		"PT": "Portogallo",
+		"RE": "Riunione",
+		"RW": "Ruanda",
+		"SA": "Arabia Saudita",
+		"SB": "Isole Salomone",
+		"SE": "Svezia",
+		"SH": "Sant'Elena, Ascensione e Tristan da Cunha",
+		"SK": "Slovacchia",
+		"SS": "Sudan del sud",
+		"ST": "São Tomé e Príncipe",
+		"SX": "Sint Maarten (Olanda)",
+		"SY": "Siria",
+		"TC": "Isole Turks e Caicos",
+		"TD": "Ciad",
+		"TF": "Territori francesi meridionali",
+		"TH": "Thailandia",
+		"TJ": "Tagikistan",
+		"TL": "Timor Est",
+		"TT": "Trinidad e Tobago",
+		"TW": "Taiwan, Repubblica di Cina",
+		"UA": "Ucraina",
+		"UM": "Isole minori esterne degli Stati Uniti d'America",
+		"US": "Stati Uniti",
+		"VA": "Santa Sede (Stato della Città del Vaticano)",
+		"VC": "Saint Vincent e Grenadine",
+		"VE": "Venezuela, Repubblica bolivariana del",
+		"VG": "Isole Vergini, Regno Unito",
+		"VI": "Isole Vergini, U.S.A.",
+		"WF": "Wallis e Futuna",
+		"ZA": "Sudafrica",
+	},
+	"ja": {
+		"AD": "アンドラ",
+		"AE": "アラブ首長国連邦",
+		"AF": "アフガニスタン",
+		"AG": "アンティグア・バーブーダ",
+		"AI": "アングイラ",
+		"AL": "アルバニア",
+		"AM": "アルメニア",
+		"AO": "アンゴラ",
+		"AQ": "南極大陸",
+		"AR": "アルゼンチン",
+		"AS": "米領サモア",
+		"AT": "オーストリア",
+		"AU": "オーストラリア連邦",
+		"AW": "アルーバ",
+		"AX": "オーランド諸島",
+		"AZ": "アゼルバイジャン",
+		"BA": "ボスニア・ヘルツェゴビナ",
+		"BB": "バルバドス",
+		"BD": "バングラデシュ",
+		"BE": "ベルギー",
+		"BF": "ブルキナファソ",
+		"BG": "ブルガリア",
+		"BH": "バーレーン",
+		"BI": "ブルンジ",
+		"BJ": "ベナン",
+		"BL": "サンバルテルミ",
+		"BM": "バーミューダ",
+		"BN": "ブルネイ・ダルサラーム国",
+		"BO": "ボリビア",
+		"BQ": "ボネール、シントユースタティウス及びサバ",
+		"BR": "ブラジル",
+		"BS": "バハマ",
+		"BT": "ブータン",
+		"BW": "ボツワナ",
+		"BY": "ベラルーシ",
+		"BZ": "ベリーズ",
+		"CA": "カナダ",
+		"CC": "ココス (キーリング) 諸島",
+		"CD": "コンゴ民主共和国",
+		"CF": "中央アフリカ共和国",
+		"CG": "コンゴ",
+		"CH": "スイス",
+		"CI": "コートジボワール",
+		"CK": "クック諸島",
+		"CL": "チリ",
+		"CM": "カメルーン",
+		"CN": "中国",
+		"CO": "コロンビア",
+		"CR": "コスタリカ",
+		"CU": "キューバ",
+		"CV": "カーボヴェルデ",
+		"CW": "キュラソー",
+		"CX": "クリスマス島",
+		"CY": "キプロス",
+		"DE": "ドイツ",
+		"DJ": "ジブチ",
+		"DK": "デンマーク",
+		"DM": "ドミニカ",
+		"DO": "ドミニカ共和国",
+		"DZ": "アルジェリア",
+		"EC": "エクアドル",
+		"EE": "エストニア",
+		"EG": "エジプト",
+		"EH": "西サハラ",
+		"ER": "エリトリア国",
+		"ES": "スペイン",
+		"ET": "エチオピア",
+		"FI": "フィンランド",
+		"FJ": "フィジー",
+		"FK": "フォークランド諸島 (マルビナス)",
+		"FM": "ミクロネシア連邦",
+		"FO": "フェロー諸島",
+		"FR": "フランス",
+		"GA": "ガボン",
+		"GB": "英国",
+		"GD": "グレナダ",
+		"GE": "グルジア",
+		"GF": "仏領ギアナ",
+		"GG": "ガーンジー",
+		"GH": "ガーナ",
+		"GI": "ジブラルタル",
+		"GL": "グリーンランド",
+		"GM": "ガンビア",
+		"GN": "ギニア",
+		"GP": "グアドループ",
+		"GQ": "赤道ギニア",
+		"GR": "ギリシャ",
+		"GS": "サウスジョージア及びサウスサンドウィッチ諸島",
+		"GT": "グアテマラ",
+		"GU": "グアム",
+		"GW": "ギニアビサウ",
+		"GY": "ガイアナ",
+		"HK": "香港",
+		"HN": "ホンジュラス",
+		"HR": "クロアチア",
+		"HT": "ハイチ",
+		"HU": "ハンガリー",
+		"ID": "インドネシア",
+		"IE": "アイルランド",
+		"IL": "イスラエル",
+		"IM": "マン島",
+		"IN": "インド",
+		"IO": "英国インド洋領土",
+		"IQ": "イラク",
+		"IR": "イラン・イスラム共和国",
+		"IS": "アイスランド",
+		"IT": "イタリア",
+		"JE": "ジャージー",
+		"JM": "ジャマイカ",
+		"JO": "ヨルダン",
+		"JP": "日本",
+		"KE": "ケニア",
+		"KG": "キルギスタン",
+		"KH": "カンボジア",
+		"KI": "キリバス",
+		"KM": "コモロ",
+		"KN": "セントクリストファー・ネーヴィス",
+		"KP": "朝鮮民主主義人民共和国",
+		"KR": "大韓民国 (韓国)",
+		"KW": "クウェート",
+		"KY": "ケイマン諸島",
+		"KZ": "カザフスタン",
+		"LA": "ラオス人民民主共和国",
+		"LB": "レバノン",
+		"LC": "セントルシア",
+		"LI": "リヒテンシュタイン",
+		"LK": "スリランカ",
+		"LR": "リベリア",
+		"LS": "レソト",
+		"LT": "リトアニア",
+		"LU": "ルクセンブルク",
+		"LV": "ラトビア",
+		"LY": "リビア",
+		"MA": "モロッコ",
+		"MC": "モナコ",
+		"MD": "モルドバ",
+		"ME": "モンテネグロ",
+		"MF": "サンマルタン (仏領)",
+		"MG": "マダガスカル",
+		"MH": "マーシャル諸島",
+		"ML": "マリ",
+		"MM": "ミャンマー",
+		"MN": "モンゴル国",
+		"MO": "マカオ",
+		"MQ": "マルティニーク",
+		"MR": "モーリタニア",
+		"MS": "モントセラト",
+		"MT": "マルタ",
+		"MU": "モーリシャス",
+		"MV": "モルディブ",
+		"MW": "マラウイ",
+		"MX": "メキシコ",
+		"MY": "マレーシア",
+		"MZ": "モザンビーク",
+		"NA": "ナミビア",
+		"NC": "ニューカレドニア",
+		"NE": "ニジェール",
+		"NF": "ノーフォーク島",
+		"NG": "ナイジェリア",
+		"NI": "ニカラグア",
+		"NL": "オランダ",
+		"NO": "ノルウェー",
+		"NP": "ネパール",
+		"NR": "ナウル",
+		"NU": "ニウエ",
+		"NZ": "ニュージーランド",
+		"OM": "オマーン",
+		"PA": "パナマ",
+		"PE": "ペルー",
+		"PF": "仏領ポリネシア",
+		"PG": "パプアニューギニア",
+		"PH": "フィリピン",
+		"PK": "パキスタン",
+		"PL": "ポーランド",
+		"PM": "サンピエール及びミクロン",
+		"PN": "ピトケアン",
+		"PR": "プエルトリコ",
+		"PS": "パレスチナ",
+		"PT": "ポルトガル",
+		"PW": "パラオ",
+		"PY": "パラグアイ",
+		"QA": "カタール",
+		"RE": "レユニオン",
+		"RO": "ルーマニア",
+		"RS": "セルビア",
+		"RU": "ロシア連邦",
+		"RW": "ルワンダ",
+		"SA": "サウジアラビア",
+		"SB": "ソロモン諸島",
+		"SC": "セーシェル",
+		"SD": "スーダン",
+		"SE": "スウェーデン",
+		"SG": "シンガポール",
+		"SH": "セントヘレナ、アセンション及びトリスタン・ダ・クーニャ",
+		"SI": "スロベニア",
+		"SK": "スロバキア",
+		"SL": "シエラレオネ",
+		"SM": "サンマリノ",
+		"SN": "セネガル",
+		"SO": "ソマリア",
+		"SR": "スリナム",
+		"SS": "南スーダン",
+		"ST": "サントメ・プリンシペ",
+		"SV": "エルサルバドル",
+		"SX": "サンマルタン (オランダ領)",
+		"SY": "シリア・アラブ共和国",
+		"TC": "タークス及びカイコス諸島",
+		"TD": "チャド",
+		"TF": "フランス南方領土",
+		"TG": "トーゴ",
+		"TH": "タイ",
+		"TJ": "タジキスタン",
+		"TK": "トケラウ",
+		"TL": "東ティモール",
+		"TM": "トルクメニスタン",
+		"TN": "チュニジア",
+		"TO": "トンガ",
+		"TT": "トリニダード・トバゴ",
+		"TV": "ツバル",
+		"TW": "台湾",
+		"TZ": "タンザニア",
+		"UA": "ウクライナ",
+		"UG": "ウガンダ",
+		"UM": "アメリカ合衆国外諸島",
+		"US": "米国",
+		"UY": "ウルグアイ",
+		"UZ": "ウズベキスタン",
+		"VA": "聖庁 (バチカン市国)",
+		"VC": "セントビンセント及びグレナディーン諸島",
+		"VE": "ベネズエラ",
+		"VG": "英領ヴァージン諸島",
+		"VI": "米領ヴァージン諸島",
+		"VN": "ベトナム",
+		"VU": "バヌアツ",
+		"WF": "ワリー及びフテュナ",
+		"WS": "サモア",
+		"YE": "イエメン",
+		"YT": "マヨット",
+		"ZA": "南アフリカ",
+		"ZM": "ザンビア",
+		"ZW": "ジンバブエ",
+	},
+	"ko": {
+		"AD": "안도라",
+		"AE": "아랍에미리트",
+		"AF": "아프가니스탄",
+		"AG": "앤티가 바부다",
+		"AI": "앵귈라",
+		"AL": "알바니아",
+		"AM": "아르메니아",
+		"AO": "앙골라",
+		"AQ": "남극",
+		"AR": "아르헨티나",
+		"AS": "아메리칸사모아",
+		"AT": "오스트리아",
+		"AU": "오스트레일리아",
+		"AW": "아루바",
+		"AX": "올란드 제도",
+		"AZ": "아제르바이잔",
+		"BA": "보스니아 헤르체고비나",
+		"BB": "바베이도스",
+		"BD": "방글라데시",
+		"BE": "벨기에",
+		"BF": "부르키나파소",
+		"BG": "불가리아",
+		"BH": "바레인",
+		"BI": "부룬디",
+		"BJ": "베냉",
+		"BL": "생바르텔레미",
+		"BM": "버뮤다",
+		"BN": "브루나이 다루살람",
+		"BO": "볼리비아",
+		"BQ": "보네르, 신트외스타티위스, 사바 섬",
+		"BR": "브라질",
+		"BS": "바하마",
+		"BT": "부탄",
+		"BW": "보츠와나",
+		"BY": "벨라루스",
+		"BZ": "벨리즈",
+		"CA": "캐나다",
+		"CC": "코코스 제도",
+		"CD": "콩고 민주 공화국",
+		"CF": "중앙아프리카 공화국",
+		"CG": "콩고",
+		"CH": "스위스",
+		"CI": "코트디부아르",
+		"CK": "쿡 제도",
+		"CL": "칠레",
+		"CM": "카메룬",
+		"CN": "중국",
+		"CO": "콜롬비아",
+		"CR": "코스타리카",
+		"CU": "쿠바",
+		"CV": "카보베르데",
+		"CW": "퀴라소",
+		"CX": "크리스마스 섬",
+		"CY": "키프로스",
+		"CZ": "체코",
+		"DE": "독일",
+		"DJ": "지부티",
+		"DK": "덴마크",
+		"DM": "도미니카 연방",
+		"DO": "도미니카 공화국",
+		"DZ": "알제리",
+		"EC": "에콰도르",
+		"EE": "에스토니아",
+		"EG": "이집트",
+		"EH": "서사하라",
+		"ER": "에리트레아",
+		"ES": "스페인",
+		"ET": "에티오피아",
+		"FI": "핀란드",
+		"FJ": "피지",
+		"FK": "포클랜드 제도 (말비나스)",
+		"FM": "미크로네시아 연방",
+		"FO": "페로 제도",
+		"FR": "프랑스",
+		"GA": "가봉",
+		"GB": "영국",
+		"GD": "그레나다",
+		"GE": "조지아",
+		"GF": "프랑스령 기아나",
+		"GG": "건지 섬",
+		"GH": "가나",
+		"GI": "지브롤터",
+		"GL": "그린란드",
+		"GM": "감비아",
+		"GN": "기니",
+		"GP": "과들루프",
+		"GQ": "적도 기니",
+		"GR": "그리스",
+		"GS": "사우스조지아 사우스샌드위치 제도",
+		"GT": "과테말라",
+		"GU": "괌",
+		"GW": "기니비사우",
+		"GY": "가이아나",
+		"HK": "홍콩",
+		"HN": "온두라스",
+		"HR": "크로아티아",
+		"HT": "아이티",
+		"HU": "헝가리",
+		"ID": "인도네시아",
+		"IE": "아일랜드",
+		"IL": "이스라엘",
+		"IM": "맨 섬",
+		"IN": "인도",
+		"IO": "영국령 인도양 지역",
+		"IQ": "이라크",
+		"IR": "이란 이슬람 공화국",
+		"IS": "아이슬란드",
+		"IT": "이탈리아",
+		"JE": "저지 섬",
+		"JM": "자메이카",
+		"JO": "요르단",
+		"JP": "일본",
+		"KE": "케냐",
+		"KG": "키르기스스탄",
+		"KH": "캄보디아",
+		"KI": "키리바시",
+		"KM": "코모로",
+		"KN": "세인트키츠 네비스",
+		"KP": "조선민주주의인민공화국",
+		"KR": "대한민국",
+		"KW": "쿠웨이트",
+		"KY": "케이맨 제도",
+		"KZ": "카자흐스탄",
+		"LA": "라오 인민 민주주의 공화국",
+		"LB": "레바논",
+		"LC": "세인트루시아",
+		"LI": "리히텐슈타인",
+		"LK": "스리랑카",
+		"LR": "라이베리아",
+		"LS": "레소토",
+		"LT": "리투아니아",
+		"LU": "룩셈부르크",
+		"LV": "라트비아",
+		"LY": "리비아",
+		"MA": "모로코",
+		"MC": "모나코",
+		"MD": "몰도바",
+		"ME": "몬테네그로",
+		"MF": "생마르탱 (프랑스령)",
+		"MG": "마다가스카르",
+		"MH": "마셜 제도",
+		"MK": "북마케도니아",
+		"ML": "말리",
+		"MM": "미얀마",
+		"MN": "몽골",
+		"MO": "마카오",
+		"MQ": "마르티니크",
+		"MR": "모리타니",
+		"MS": "몬트세랫",
+		"MT": "몰타",
+		"MU": "모리셔스",
+		"MV": "몰디브",
+		"MW": "말라위",
+		"MX": "멕시코",
+		"MY": "말레이시아",
+		"MZ": "모잠비크",
+		"NA": "나미비아",
+		"NC": "누벨칼레도니",
+		"NE": "니제르",
+		"NF": "노퍽 섬",
+		"NG": "나이지리아",
+		"NI": "니카라과",
+		"NL": "네덜란드",
+		"NO": "노르웨이",
+		"NP": "네팔",
+		"NR": "나우루",
+		"NU": "니우에",
+		"NZ": "뉴질랜드",
+		"OM": "오만",
+		"PA": "파나마",
+		"PE": "페루",
+		"PF": "프랑스령 폴리네시아",
+		"PG": "파푸아뉴기니",
+		"PH": "필리핀",
+		"PK": "파키스탄",
+		"PL": "폴란드",
+		"PM": "생피에르 미클롱",
+		"PN": "핏케언 제도",
+		"PR": "푸에르토리코",
+		"PS": "팔레스타인",
+		"PT": "포르투갈",
+		"PW": "팔라우",
+		"PY": "파라과이",
+		"QA": "카타르",
+		"RE": "레위니옹",
+		"RO": "루마니아",
+		"RS": "세르비아",
+		"RU": "러시아 연방",
+		"RW": "르완다",
+		"SA": "사우디아라비아",
+		"SB": "솔로몬 제도",
+		"SC": "세이셸",
+		"SD": "수단",
+		"SE": "스웨덴",
+		"SG": "싱가포르",
+		"SH": "세인트헬레나 어센션 트리스탄다쿠냐",
+		"SI": "슬로베니아",
+		"SK": "슬로바키아",
+		"SL": "시에라리온",
+		"SM": "산마리노",
+		"SN": "세네갈",
+		"SO": "소말리아",
+		"SR": "수리남",
+		"SS": "남수단",
+		"ST": "상투메 프린시페",
+		"SV": "엘살바도르",
+		"SX": "신트마르턴 (네덜란드령)",
+		"SY": "시리아 아랍 공화국",
+		"SZ": "에스와티니",
+		"TC": "터크스 케이커스 제도",
+		"TD": "차드",
+		"TF": "프랑스령 남 자치구역",
+		"TG": "토고",
+		"TH": "태국",
+		"TJ": "타지키스탄",
+		"TK": "토켈라우",
+		"TL": "동티모르",
+		"TM": "투르크메니스탄",
+		"TN": "튀니지",
+		"TO": "통가",
+		"TR": "튀르키예",
+		"TT": "트리니다드 토바고",
+		"TV": "투발루",
+		"TW": "타이완",
+		"TZ": "탄자니아",
+		"UA": "우크라이나",
+		"UG": "우간다",
+		"UM": "미국령 군소 제도",
+		"US": "미국",
+		"UY": "우루과이",
+		"UZ": "우즈베키스탄",
+		"VA": "바티칸 시티 (Holy See)",
+		"VC": "세인트빈센트 그레나딘",
+		"VE": "베네수엘라",
+		"VG": "버진 제도, 영국령",
+		"VI": "버진 제도, 미국령",
+		"VN": "베트남",
+		"VU": "바누아투",
+		"WF": "왈리스 퓌튀나",
+		"WS": "사모아",
+		"YE": "예멘",
+		"YT": "마요트",
+		"ZA": "남아프리카 공화국",
+		"ZM": "잠비아",
+		"ZW": "짐바브웨",
+	},
+	"nl": {
+		"AE": "Verenigde Arabische Emiraten",
+		"AG": "Antigua en Barbuda",
+		"AL": "Albanië",
+		"AM": "Armenië",
+		"AR": "Argentinië",
+		"AS": "Amerikaans-Samoa",
+		"AT": "Oostenrijk",
+		"AU": "Australië",
+		"AX": "Ålandseilanden",
+		"AZ": "Azerbeidzjan",
+		"BA": "Bosnië en Herzegovina",
+		"BE": "België",
+		"BG": "Bulgarije",
+		"BH": "Bahrein",
+		"BL": "Saint-Barthélemy",
+		"BO": "Bolivia, Multinationale Staat",
+		"BQ": "Bonaire, Sint Eustatius en Saba",
+		"BR": "Brazilië",
+		"BS": "Bahama's",
+		"BY": "Wit-Rusland",
+		"CC": "Cocoseilanden (Keelingeilanden)",
+		"CD": "Congo, Democratische Republiek",
+		"CF": "Centraal-Afrikaanse Republiek",
+		"CH": "Zwitserland",
+		"CI": "Ivoorkust",
+		"CK": "Cookeilanden",
+		"CL": "Chili",
+		"CM": "Kameroen",
+		"CV": "Kaapverdië",
+		"CX": "Christmaseiland",
+		"CZ": "Tsjechië",
+		"DE": "Duitsland",
+		"DK": "Denemarken",
+		"DO": "Dominicaanse Republiek",
+		"DZ": "Algerije",
+		"EE": "Estland",
+		"EG": "Egypte",
+		"EH": "Westelijke Sahara",
+		"ES": "Spanje",
+		"ET": "Ethiopië",
+		"FK": "Falklandeilanden (Malvinas)",
+		"FO": "Faeröer",
+		"FR": "Frankrijk",
+		"GB": "Verenigd Koninkrijk",
+		"GF": "Frans-Guyana",
+		"GL": "Groenland",
+		"GN": "Guinee",
+		"GQ": "Equatoriaal-Guinea",
+		"GR": "Griekenland",
+		"GS": "Zuid-Georgia en de Zuidelijke Sandwicheilanden",
+		"GW": "Guinee-Bissau",
+		"HK": "Hongkong",
+		"HR": "Kroatië",
+		"HT": "Haïti",
+		"HU": "Hongarije",
+		"ID": "Indonesië",
+		"IE": "Ierland",
+		"IL": "Israël",
+		"IM": "Eiland Man",
+		"IO": "Brits Indische Oceaanterritorium",
+		"IQ": "Irak",
+		"IS": "IJsland",
+		"IT": "Italië",
+		"JO": "Jordanië",
+		"KE": "Kenia",
+		"KG": "Kirgizië",
+		"KH": "Cambodja",
+		"KM": "Comoren",
+		"KN": "Saint Kitts en Nevis",
+		"KP": "Noord-Korea",
+		"KR": "Zuid-Korea",
+		"KW": "Koeweit",
+		"KY": "Kaaimaneilanden",
+		"KZ": "Kazachstan",
+		"LA": "Laos Democratische Volksrepubliek",
+		"LB": "Libanon",
+		"LT": "Litouwen",
+		"LU": "Luxemburg",
+		"LV": "Letland",
+		"LY": "Libië",
+		"MA": "Marokko",
+		"MD": "Moldavië",
+		"MF": "Sint-Maarten (Frans deel)",
+		"MG": "Madagaskar",
+		"MH": "Marshalleilanden",
+		"MK": "Noord-Macedonië",
+		"MN": "Mongolië",
+		"MO": "Macau",
+		"MR": "Mauritanië",
+		"MV": "Maldiven",
+		"MY": "Maleisië",
+		"NA": "Namibië",
+		"NC": "Nieuw-Caledonië",
+		"NF": "Norfolk",
+		"NL": "Nederland",
+		"NO": "Noorwegen",
+		"NZ": "Nieuw-Zeeland",
+		"PF": "Frans-Polynesië",
+		"PG": "Papoea-Nieuw-Guinea",
+		"PH": "Filipijnen",
+		"PL": "Polen",
+		"PM": "Saint-Pierre en Miquelon",
+		"PN": "Pitcairneilanden",
+		"PS": "Palestina, Staat",
+		"RO": "Roemenië",
+		"RS": "Servië",
+		"RU": "Rusland",
+		"SA": "Saoedi-Arabië",
+		"SB": "Salomonseilanden",
+		"SC": "Seychellen",
+		"SD": "Soedan",
+		"SE": "Zweden",
+		"SH": "Sint-Helena, Ascension en Tristan da Cunha",
+		"SI": "Slovenië",
+		"SK": "Slowakije",
+		"SO": "Somalië",
+		"SS": "Zuid-Soedan",
+		"ST": "Sao Tomé en Principe",
+		"SX": "Sint Maarten (Nederlands deel)",
+		"SY": "Syrië",
+		"TC": "Turks- en Caicoseilanden",
+		"TD": "Tsjaad",
+		"TF": "Franse Zuidelijke Gebieden",
+		"TJ": "Tadzjikistan",
+		"TL": "Oost-Timor",
+		"TN": "Tunesië",
+		"TR": "Turkije",
+		"TT": "Trinidad en Tobago",
+		"UA": "Oekraïne",
+		"UG": "Oeganda",
+		"UM": "Kleine afgelegen eilanden van de Verenigde Staten",
+		"US": "Verenigde Staten",
+		"UZ": "Oezbekistan",
+		"VA": "Vaticaanstad, Staat",
+		"VC": "Saint Vincent en de Grenadines",
+		"VE": "Venezuela, Bolivariaanse Republiek",
+		"VG": "Maagdeneilanden, Britse",
+		"VI": "Maagdeneilanden, Amerikaanse",
+		"WF": "Wallis en Futuna",
+		"YE": "Jemen",
+		"ZA": "Zuid-Afrika",
+	},
+	"pl": {
+		"AD": "Andora",
+		"AE": "Zjednoczone Emiraty Arabskie",
+		"AF": "Afganistan",
+		"AG": "Antigua i Barbuda",
+		"AQ": "Antarktyka",
+		"AR": "Argentyna",
+		"AS": "Samoa Amerykańskie",
+		"AX": "Wyspy Alandzkie",
+		"AZ": "Azerbejdżan",
+		"BA": "Bośnia i Hercegowina",
+		"BD": "Bangladesz",
+		"BE": "Belgia",
+		"BG": "Bułgaria",
+		"BH": "Bahrajn",
+		"BL": "Saint-Barthélemy",
+		"BM": "Bermudy",
+		"BN": "Państwo Brunei",
+		"BO": "Boliwia",
+		"BQ": "Bonaire, Sint Eustatius i Saba",
+		"BR": "Brazylia",
+		"BS": "Bahamy",
+		"BY": "Białoruś",
+		"CA": "Kanada",
+		"CC": "Wyspy Kokosowe (Wyspy Keelinga)",
+		"CD": "Kongo, Demokratyczna Republika Konga",
+		"CF": "Republika Środkowoafrykańska",
+		"CG": "Kongo",
+		"CH": "Szwajcaria",
+		"CI": "Wybrzeże Kości Słoniowej",
+		"CK": "Wyspy Cooka",
+		"CM": "Kamerun",
+		"CN": "Chiny",
+		"CO": "Kolumbia",
+		"CR": "Kostaryka",
+		"CU": "Kuba",
+		"CV": "Republika Zielonego Przylądka",
+		"CX": "Wyspa Bożego Narodzenia",
+		"CY": "Cypr",
+		"CZ": "Czechy",
+		"DE": "Niemcy",
+		"DJ": "Dżibuti",
+		"DK": "Dania",
+		"DM": "Dominika",
+		"DO": "Republika Dominikańska",
+		"DZ": "Algieria",
+		"EC": "Ekwador",
+		"EG": "Egipt",
+		"EH": "Sahara Zachodnia",
+		"ER": "Erytrea",
+		"ES": "Hiszpania",
+		"ET": "Etiopia",
+		"FI": "Finlandia",
+		"FJ": "Fidżi",
+		"FK": "Falklandy (Malwiny)",
+		"FM": "Mikronezja",
+		"FO": "Wyspy Owcze",
+		"FR": "Francja",
+		"GB": "Wielka Brytania",
+		"GE": "Gruzja",
+		"GF": "Gujana Francuska",
+		"GL": "Grenlandia",
+		"GN": "Gwinea",
+		"GP": "Gwadelupa",
+		"GQ": "Gwinea Równikowa",
+		"GR": "Grecja",
+		"GS": "Georgia Południowa i Sandwich Południowy",
+		"GT": "Gwatemala",
+		"GW": "Gwinea Bissau",
+		"GY": "Gujana",
+		"HK": "Hongkong",
+		"HR": "Chorwacja",
+		"HU": "Węgry",
+		"ID": "Indonezja",
+		"IE": "Irlandia",
+		"IL": "Izrael",
+		"IM": "Wyspa Man",
+		"IN": "Indie",
+		"IO": "Brytyjskie Terytorium Oceanu Indyjskiego",
+		"IQ": "Irak",
+		"IR": "Iran, Islamska Republika",
+		"IS": "Islandia",
+		"IT": "Włochy",
+		"JM": "Jamajka",
+		"JO": "Jordania",
+		"JP": "Japonia",
+		"KE": "Kenia",
+		"KG": "Kirgistan",
+		"KH": "Kambodża",
+		"KM": "Komory",
+		"KN": "Saint Kitts i Nevis",
+		"KP": "Korea Północna",
+		"KR": "Korea Południowa",
+		"KW": "Kuwejt",
+		"KY": "Kajmany",
+		"KZ": "Kazachstan",
+		"LA": "Laotańska Republika Ludowo-Demokratyczna",
+		"LB": "Liban",
+		"LT": "Litwa",
+		"LU": "Luksemburg",
+		"LV": "Łotwa",
+		"LY": "Libia",
+		"MA": "Maroko",
+		"MC": "Monako",
+		"MD": "Mołdawia",
+		"ME": "Czarnogóra",
+		"MF": "Saint-Martin (część francuska)",
+		"MG": "Madagaskar",
+		"MH": "Wyspy Marshalla",
+		"MK": "Macedonia Północna",
+		"MM": "Mjanma",
+		"MO": "Makau",
+		"MQ": "Martynika",
+		"MR": "Mauretania",
+		"MV": "Malediwy",
+		"MX": "Meksyk",
+		"MY": "Malezja",
+		"MZ": "Mozambik",
+		"NC": "Nowa Kaledonia",
+		"NF": "Wyspy Norfolk",
+		"NI": "Nikaragua",
+		"NL": "Holandia",
+		"NO": "Norwegia",
+		"NZ": "Nowa Zelandia",
+		"PF": "Polinezja Francuska",
+		"PG": "Papua-Nowa Gwinea",
+		"PH": "Filipiny",
+		"PL": "Polska",
+		"PM": "Saint-Pierre i Miquelon",
+		"PR": "Portoryko",
+		"PS": "Palestyna (państwo)",
+		"PT": "Portugalia",
+		"PY": "Paragwaj",
+		"QA": "Katar",
+		"RE": "Reunion",
+		"RO": "Rumunia",
+		"RU": "Federacja Rosyjska",
+		"RW": "Ruanda",
+		"SA": "Arabia Saudyjska",
+		"SB": "Wyspy Salomona",
+		"SC": "Seszele",
+		"SE": "Szwecja",
+		"SG": "Singapur",
+		"SH": "Wyspa Świętej Heleny, Wyspa Wniebowstąpienia i Tristan da Cunha",
+		"SI": "Słowenia",
+		"SK": "Słowacja",
+		"SR": "Surinam",
+		"SS": "Sudan Południowy",
+		"ST": "Wyspy Świętego Tomasza i Książęca",
+		"SV": "Salwador",
+		"SX": "Sint Maarten (część holenderska)",
+		"SY": "Syryjska Republika Arabska",
+		"TC": "Turks i Caicos",
+		"TD": "Czad",
+		"TF": "Francuskie Terytoria Południowe",
+		"TH": "Tajlandia",
+		"TJ": "Tadżykistan",
+		"TL": "Timor Wschodni",
+		"TN": "Tunezja",
+		"TR": "Turcja",
+		"TT": "Trynidad i Tobago",
+		"TW": "Tajwan",
+		"TZ": "Tanzania, Zjednoczona Republika",
+		"UA": "Ukraina",
+		"UM": "Dalekie Wyspy Mniejsze Stanów Zjednoczonych",
+		"US": "Stany Zjednoczone",
+		"UY": "Urugwaj",
+		"VA": "Państwo Watykańskie (Stolica Apostolska)",
+		"VC": "Saint Vincent i Grenadyny",
+		"VE": "Wenezuela",
+		"VG": "Brytyjskie Wyspy Dziewicze",
+		"VI": "Wyspy Dziewicze Stanów Zjednoczonych",
+		"VN": "Wietnam",
+		"WF": "Wallis i Futuna",
+		"YE": "Jemen",
+		"YT": "Majotta",
+		"ZA": "Południowa Afryka",
+	},
+	"pt": {
+		"AE": "Emirados Árabes Unidos",
+		"AF": "Afeganistão",
+		"AG": "Antígua e Barbuda",
+		"AL": "Albânia",
+		"AM": "Arménia",
+		"AQ": "Antártida",
+		"AS": "Samoa Americana",
+		"AT": "Áustria",
+		"AU": "Austrália",
+		"AX": "Ilhas Alanda",
+		"AZ": "Azerbaijão",
+		"BA": "Bósnia e Herzegovina",
+		"BD": "Bangladeche",
+		"BE": "Bélgica",
+		"BG": "Bulgária",
+		"BH": "Barém",
+		"BJ": "Benim",
+		"BM": "Bermudas",
+		"BO": "Bolívia",
+		"BQ": "Bonaire, Santo Eustáquio e Saba",
+		"BR": "Brasil",
+		"BT": "Butão",
+		"BW": "Botsuana",
+		"BY": "Bielorússia",
+		"CA": "Canadá",
+		"CC": "Ilhas Cocos",
+		"CD": "Congo, República Democrática do",
+		"CF": "República Centro-Africana",
+		"CH": "Suíça",
+		"CI": "Costa do Marfim",
+		"CK": "Ilhas Cook",
+		"CM": "Camarões",
+		"CO": "Colômbia",
+		"CW": "Curação",
+		"CX": "Ilha Natal",
+		"CY": "Chipre",
+		"CZ": "Chéquia",
+		"DE": "Alemanha",
+		"DK": "Dinamarca",
+		"DO": "República Dominicana",
+		"DZ": "Argélia",
+		"EC": "Equador",
+		"EE": "Estónia",
+		"EG": "Egito",
+		"EH": "Saara Ocidental",
+		"ER": "Eritreia",
+		"ES": "Espanha",
+		"ET": "Etiópia",
+		"FI": "Finlândia",
+		"FK": "Ilhas Falkland (Malvinas)",
+		"FM": "Micronésia, Estados Federados da",
+		"FO": "Ilhas Faroé",
+		"FR": "França",
+		"GA": "Gabão",
+		"GB": "Reino Unido",
+		"GD": "Granada",
+		"GE": "Geórgia",
+		"GF": "Guiana Francesa",
+		"GH": "Gana",
+		"GL": "Gronelândia",
+		"GM": "Gâmbia",
+		"GN": "Guiné",
+		"GP": "Guadalupe",
+		"GQ": "Guiné Equatorial",
+		"GR": "Grécia",
+		"GS": "Ilhas Geórgia do Sul e Sandwich do Sul",
+		"GW": "Guiné-Bissáu",
+		"GY": "Guiana",
+		"HR": "Croácia",
+		"HU": "Hungria",
+		"ID": "Indonésia",
+		"IE": "Irlanda",
+		"IM": "Ilha de Man",
+		"IN": "Índia",
+		"IO": "Território Britânico do Oceano Índico",
+		"IQ": "Iraque",
+		"IR": "Irão, República Islâmica do",
+		"IS": "Islândia",
+		"IT": "Itália",
+		"JO": "Jordânia",
+		"JP": "Japão",
+		"KE": "Quénia",
+		"KG": "Quirguistão",
+		"KH": "Camboja",
+		"KM": "Comores",
+		"KN": "São Cristóvão e Nevis",
+		"KP": "Coreia do Norte",
+		"KR": "Coreia do Sul",
+		"KY": "Ilhas Caimão",
+		"KZ": "Cazaquistão",
+		"LA": "República Democrática Popular do Laos",
+		"LB": "Líbano",
+		"LC": "Santa Lúcia",
+		"LR": "Libéria",
+		"LS": "Lesoto",
+		"LT": "Lituânia",
+		"LU": "Luxemburgo",
+		"LV": "Letónia",
+		"LY": "Líbia",
+		"MA": "Marrocos",
+		"MC": "Mónaco",
+		"MD": "Moldávia",
+		"MF": "São Martin (Território Francês)",
+		"MG": "Madagáscar",
+		"MH": "Ilhas Marshall",
+		"MK": "Macedónia do Norte",
+		"MM": "Birmânia",
+		"MN": "Mongólia",
+		"MO": "Macau",
+		"MQ": "Martinica",
+		"MR": "Mauritânia",
+		"MS": "Monserrate",
+		"MU": "Maurícia",
+		"MV": "Maldivas",
+		"MX": "México",
+		"MY": "Malásia",
+		"MZ": "Moçambique",
+		"NA": "Namíbia",
+		"NC": "Nova Caledónia",
+		"NE": "Níger",
+		"NF": "Ilha Norfolk",
+		"NG": "Nigéria",
+		"NI": "Nicarágua",
+		"NL": "Países Baixos",
+		"NO": "Noruega",
+		"NZ": "Nova Zelândia",
+		"OM": "Omã",
+		"PA": "Panamá",
+		"PF": "Polinésia Francesa",
+		"PG": "Papua Nova Guiné",
+		"PH": "Filipinas",
+		"PK": "Paquistão",
+		"PL": "Polónia",
+		"PM": "Saint Pierre e Miquelon",
+		"PR": "Porto Rico",
+		"PS": "Palestina, Estado da",
+		"PY": "Paraguai",
+		"QA": "Catar",
+		"RE": "Ilha Reunião",
+		"RO": "Roménia",
+		"RS": "Sérvia",
+		"RU": "Federação Russa",
+		"RW": "Ruanda",
+		"SA": "Arábia Saudita",
+		"SB": "Ilhas Salomão",
+		"SD": "Sudão",
+		"SE": "Suécia",
+		"SG": "Singapura",
+		"SH": "Santa Helena, Ascensão e Tristão da Cunha",
+		"SI": "Eslovénia",
+		"SK": "Eslováquia",
+		"SL": "Serra Leoa",
+		"SO": "Somália",
+		"SS": "Sudão do Sul",
+		"ST": "São Tomé e Príncipe",
+		"SX": "São Martinho (Países Baixos)",
+		"SY": "República Árabe Síria",
+		"SZ": "Suazilândia",
+		"TC": "Ilhas Turcas e Caicos",
+		"TD": "Chade",
+		"TF": "Territórios Franceses do Sul",
+		"TH": "Tailândia",
+		"TJ": "Tajiquistão",
+		"TM": "Turquemenistão",
+		"TN": "Tunísia",
+		"TR": "Turquia",
+		"TT": "Trindade e Tobago",
+		"TW": "Taiwan, Província da China",
+		"TZ": "Tanzânia",
+		"UA": "Ucrânia",
+		"UM": "Ilhas Menores Distantes dos Estados Unidos",
+		"US": "Estados Unidos",
+		"UY": "Uruguai",
+		"UZ": "Uzbequistão",
+		"VA": "Santa Sé (Estado da Cidade do Vaticano)",
+		"VC": "São Vicente e Granadinas",
+		"VE": "Venezuela, República Bolivariana da",
+		"VG": "Ilhas Virgens, Britânicas",
+		"VI": "Ilhas Virgens, Estados Unidos",
+		"VN": "Vietname",
+		"WF": "Wallis e Futuna",
+		"YE": "Iémen",
+		"ZA": "África do Sul",
+		"ZM": "Zâmbia",
+		"ZW": "Zimbábue",
+	},
+	"ru": {
+		"AD": "Андорра",
+		"AE": "Объединённые Арабские Эмираты",
+		"AF": "Афганистан",
+		"AG": "Антигуа и Барбуда",
+		"AI": "Ангвилла",
+		"AL": "Албания",
+		"AM": "Армения",
+		"AO": "Ангола",
+		"AQ": "Антарктика",
+		"AR": "Аргентина",
+		"AS": "Американские Самоа",
+		"AT": "Австрия",
+		"AU": "Австралия",
+		"AW": "Аруба",
+		"AX": "Аландские острова",
+		"AZ": "Азербайджан",
+		"BA": "Босния и Герцеговина",
+		"BB": "Барбадос",
+		"BD": "Бангладеш",
+		"BE": "Бельгия",
+		"BF": "Буркина-Фасо",
+		"BG": "Болгария",
+		"BH": "Бахрейн",
+		"BI": "Бурунди",
+		"BJ": "Бенин",
+		"BL": "Сен-Бартельми",
+		"BM": "Бермуды",
+		"BN": "Бруней Даруссалам",
+		"BO": "Боливия",
+		"BQ": "Бонайре, Синт-Эстатиус и Саба",
+		"BR": "Бразилия",
+		"BS": "Багамы",
+		"BT": "Бутан",
+		"BW": "Ботсвана",
+		"BY": "Беларусь",
+		"BZ": "Белиз",
+		"CA": "Канада",
+		"CC": "Кокосовые острова",
+		"CD": "Демократическая Республика Конго",
+		"CF": "Центрально-африканская республика",
+		"CG": "Конго",
+		"CH": "Швейцария",
+		"CI": "Кот-д'Ивуар",
+		"CK": "Острова Кука",
+		"CL": "Чили",
+		"CM": "Камерун",
+		"CN": "Китай",
+		"CO": "Колумбия",
+		"CR": "Коста-Рика",
+		"CU": "Куба",
+		"CV": "Кабо-Верде",
+		"CW": "Кюрасао",
+		"CX": "Остров Рождества",
+		"CY": "Кипр",
+		"CZ": "Чехия",
+		"DE": "Германия",
+		"DJ": "Джибути",
+		"DK": "Дания",
+		"DM": "Доминика",
+		"DO": "Доминиканская республика",
+		"DZ": "Алжир",
+		"EC": "Эквадор",
+		"EE": "Эстония",
+		"EG": "Египет",
+		"EH": "Западная Сахара",
+		"ER": "Эритрея",
+		"ES": "Испания",
+		"ET": "Эфиопия",
+		"FI": "Финляндия",
+		"FJ": "Фиджи",
+		"FK": "Фолклендские (Мальвинские) острова",
+		"FM": "Федеративные Штаты Микронезии",
+		"FO": "Фарерские острова",
+		"FR": "Франция",
+		"GA": "Габон",
+		"GB": "Соединённое Королевство",
+		"GD": "Гренада",
+		"GE": "Грузия",
+		"GF": "Французская Гвиана",
+		"GG": "Гернси",
+		"GH": "Гана",
+		"GI": "Гибралтар",
+		"GL": "Гренландия",
+		"GM": "Гамбия",
+		"GN": "Гвинея",
+		"GP": "Гваделупа",
+		"GQ": "Экваториальная Гвинея",
+		"GR": "Греция",
+		"GS": "Южная Джорджия и Южные Сандвичевы острова",
+		"GT": "Гватемала",
+		"GU": "Гуам",
+		"GW": "Гвинея-Бисау",
+		"GY": "Гайана",
+		"HK": "Гонконг",
+		"HN": "Гондурас",
+		"HR": "Хорватия",
+		"HT": "Гаити",
+		"HU": "Венгрия",
+		"ID": "Индонезия",
+		"IE": "Ирландия",
+		"IL": "Израиль",
+		"IM": "Остров Мэн",
+		"IN": "Индия",
+		"IO": "Британская территория Индийского океана",
+		"IQ": "Ирак",
+		"IR": "Иран",
+		"IS": "Исландия",
+		"IT": "Италия",
+		"JE": "Джерси",
+		"JM": "Ямайка",
+		"JO": "Иордания",
+		"JP": "Япония",
+		"KE": "Кения",
+		"KG": "Киргизия",
+		"KH": "Камбоджа",
+		"KI": "Кирибати",
+		"KM": "Коморы",
+		"KN": "Сент-Китс и Невис",
+		"KP": "Северная Корея",
+		"KR": "Южная Корея",
+		"KW": "Кувейт",
+		"KY": "Каймановы острова",
+		"KZ": "Казахстан",
+		"LA": "Лаосская Народно-Демократическая Республика",
+		"LB": "Ливан",
+		"LC": "Сент-Люсия",
+		"LI": "Лихтенштейн",
+		"LK": "Шри-Ланка",
+		"LR": "Либерия",
+		"LS": "Лесото",
+		"LT": "Литва",
+		"LU": "Люксембург",
+		"LV": "Латвия",
+		"LY": "Ливия",
+		"MA": "Марокко",
+		"MC": "Монако",
+		"MD": "Молдавия",
+		"ME": "Черногория",
+		"MF": "Сен-Мартен (Франция)",
+		"MG": "Мадагаскар",
+		"MH": "Маршалловы острова",
+		"MK": "Северная Македония",
+		"ML": "Мали",
+		"MM": "Мьянма",
+		"MN": "Монголия",
+		"MO": "Макао",
+		"MQ": "Мартиника",
+		"MR": "Мавритания",
+		"MS": "Монтсеррат",
+		"MT": "Мальта",
+		"MU": "Маврикий",
+		"MV": "Мальдивы",
+		"MW": "Малави",
+		"MX": "Мексика",
+		"MY": "Малайзия",
+		"MZ": "Мозамбик",
+		"NA": "Намибия",
+		"NC": "Новая Каледония",
+		"NE": "Нигер",
+		"NF": "Остров Норфолк",
+		"NG": "Нигерия",
+		"NI": "Никарагуа",
+		"NL": "Нидерланды",
+		"NO": "Норвегия",
+		"NP": "Непал",
+		"NR": "Науру",
+		"NU": "Ниуэ",
+		"NZ": "Новая Зеландия",
+		"OM": "Оман",
+		"PA": "Панама",
+		"PE": "Перу",
+		"PF": "Французская Полинезия",
+		"PG": "Папуа — Новая Гвинея",
+		"PH": "Филиппины",
+		"PK": "Пакистан",
+		"PL": "Польша",
+		"PM": "Сен-Пьер и Микелон",
+		"PN": "Питкэрн",
+		"PR": "Пуэрто-Рико",
+		"PS": "Палестина",
+		"PT": "Португалия",
+		"PW": "Палау",
+		"PY": "Парагвай",
+		"QA": "Катар",
+		"RE": "Реюньон",
+		"RO": "Румыния",
+		"RS": "Сербия",
+		"RU": "Российская Федерация",
+		"RW": "Руанда",
+		"SA": "Саудовская Аравия",
+		"SB": "Соломоновы Острова",
+		"SC": "Сейшелы",
+		"SD": "Судан",
+		"SE": "Швеция",
+		"SG": "Сингапур",
+		"SH": "Остров Святой Елены, Остров Вознесения и Тристан-да-Кунья",
+		"SI": "Словения",
+		"SK": "Словакия",
+		"SL": "Сьерра-Леоне",
+		"SM": "Сан-Марино",
+		"SN": "Сенегал",
+		"SO": "Сомали",
+		"SR": "Суринам",
+		"SS": "Южный Судан",
+		"ST": "Сан-Томе и Принсипи",
+		"SV": "Сальвадор",
+		"SX": "Синт-Мартен (голландская часть)",
+		"SY": "Сирийская Арабская Республика",
+		"SZ": "Эсватини",
+		"TC": "Острова Туркс и Каикос",
+		"TD": "Чад",
+		"TF": "Французские южные территории",
+		"TG": "Того",
+		"TH": "Таиланд",
+		"TJ": "Таджикистан",
+		"TK": "Токелау",
+		"TL": "Восточный Тимор",
+		"TM": "Туркменистан",
+		"TN": "Тунис",
+		"TO": "Тонга",
+		"TT": "Тринидад и Тобаго",
+		"TV": "Тувалу",
+		"TW": "Тайвань",
+		"TZ": "Танзания",
+		"UA": "Украина",
+		"UG": "Уганда",
+		"UM": "Соединенные штаты Малых Удаленных островов",
+		"US": "Соединённые штаты",
+		"UY": "Уругвай",
+		"UZ": "Узбекистан",
+		"VA": "Государство-город Ватикан",
+		"VC": "Сент-Винсент и Гренадины",
+		"VE": "Венесуэла",
+		"VG": "Виргинские острова (Британия)",
+		"VI": "Виргинские острова (США)",
+		"VN": "Вьетнам",
+		"VU": "Вануату",
+		"WF": "Уоллес и Футана",
+		"WS": "Самоа",
+		"YE": "Йемен",
+		"YT": "Майот",
+		"ZA": "Южная Африка",
+		"ZM": "Замбия",
+		"ZW": "Зимбабве",
+	},
+	"sv": {
+		"AE": "Förenade Arabemiraten",
+		"AG": "Antigua och Barbuda",
+		"AL": "Albanien",
+		"AM": "Armenien",
+		"AQ": "Antarktis",
+		"AS": "Amerikanska Samoa",
+		"AT": "Österrike",
+		"AU": "Australien",
+		"AX": "Åland",
+		"AZ": "Azerbajdzjan",
+		"BA": "Bosnien-Hercegovina",
+		"BE": "Belgien",
+		"BG": "Bulgarien",
+		"BL": "Saint-Barthélemy",
+		"BO": "Bolivia, Mångnationella staten",
+		"BQ": "Bonaire, Sint Eustatius och Saba",
+		"BR": "Brasilien",
+		"BY": "Vitryssland",
+		"CA": "Kanada",
+		"CC": "Kokosöarna",
+		"CD": "Kongo, demokratiska republiken",
+		"CF": "Centralafrikanska republiken",
+		"CG": "Kongo",
+		"CH": "Schweiz",
+		"CI": "Elfenbenskusten",
+		"CK": "Cooköarna",
+		"CM": "Kamerun",
+		"CN": "Kina",
+		"CU": "Kuba",
+		"CV": "Kap Verde",
+		"CX": "Julön",
+		"CY": "Cypern",
+		"CZ": "Tjeckien",
+		"DE": "Tyskland",
+		"DK": "Danmark",
+		"DO": "Dominikanska republiken",
+		"DZ": "Algeriet",
+		"EE": "Estland",
+		"EG": "Egypten",
+		"EH": "Västsahara",
+		"ES": "Spanien",
+		"ET": "Etiopien",
+		"FK": "Falklandsöarna (Malvinas)",
+		"FM": "Mikronesien, federala staterna",
+		"FO": "Färöarna",
+		"FR": "Frankrike",
+		"GB": "Förenade kungariket",
+		"GE": "Georgien",
+		"GF": "Franska Guyana",
+		"GL": "Grönland",
+		"GQ": "Ekvatorialguinea",
+		"GR": "Grekland",
+		"GS": "Sydgeorgien och södra Sandwichöarna",
+		"HK": "Hongkong",
+		"HR": "Kroatien",
+		"HU": "Ungern",
+		"ID": "Indonesien",
+		"IE": "Irland",
+		"IN": "Indien",
+		"IO": "Brittiskt territorium i Indiska Oceanen",
+		"IQ": "Irak",
+		"IR": "Iran, islamiska republiken",
+		"IS": "Island",
+		"IT": "Italien",
+		"JO": "Jordanien",
+		"KG": "Kirgizistan",
+		"KH": "Kambodja",
+		"KM": "Comorerna",
+		"KN": "Sankt Kitts och Nevis",
+		"KP": "Nordkorea",
+		"KR": "Sydkorea",
+		"KY": "Caymanöarna",
+		"KZ": "Kazakstan",
+		"LA": "Demokratiska folkrepubliken Lao",
+		"LB": "Libanon",
+		"LC": "Sankt Lucia",
+		"LT": "Litauen",
+		"LU": "Luxemburg",
+		"LV": "Lettland",
+		"LY": "Libyen",
+		"MA": "Marocko",
+		"MD": "Moldavien",
+		"MF": "Saint Martin (franska delen)",
+		"MG": "Madagaskar",
+		"MH": "Marshallöarna",
+		"MK": "Nordmakedonien",
+		"MN": "Mongoliet",
+		"MR": "Mauretanien",
+		"MV": "Maldiverna",
+		"MX": "Mexiko",
+		"MZ": "Moçambique",
+		"NC": "Nya Kaledonien",
+		"NF": "Norfolköarna",
+		"NL": "Nederländerna",
+		"NO": "Norge",
+		"NZ": "Nya Zeeland",
+		"PF": "Franska Polynesien",
+		"PG": "Papua Nya Guinea",
+		"PH": "Filippinerna",
+		"PL": "Polen",
+		"PM": "Sankt Pierre och Miquelon",
+		"PS": "Staten Palestina",
+		"RO": "Rumänien",
+		"RS": "Serbien",
+		"RU": "Ryska federationen",
+		"SA": "Saudiarabien",
+		"SB": "Salomonöarna",
+		"SC": "Seychellerna",
+		"SE": "Sverige",
+		"SH": "Saint Helena, Ascension och Tristan da Cunha",
+		"SI": "Slovenien",
+		"SK": "Slovakien",
+		"SR": "Surinam",
+		"SS": "Sydsudan",
+		"ST": "São Tomé och Príncipe",
+		"SX": "Sint Maarten (nederländska delen)",
+		"SY": "Syrien",
+		"SZ": "Swaziland",
+		"TC": "Turks- och Caicosöarna",
+		"TD": "Tchad",
+		"TF": "Franska sydterritorierna",
+		"TJ": "Tadzjikistan",
+		"TL": "Östtimor",
+		"TN": "Tunisien",
+		"TR": "Turkiet",
+		"TT": "Trinidad och Tobago",
+		"TW": "Taiwan, provins i Kina",
+		"TZ": "Tanzania, förenade republiken",
+		"UA": "Ukraina",
+		"UM": "Förenta staternas mindre öar i Oceanien och Västindien",
+		"US": "USA",
+		"VA": "Vatikanstaten",
+		"VC": "Sankt Vincent och Grenadinerna",
+		"VE": "Venezuela, Bolivarianska republiken",
+		"VG": "Jungfruöarna, brittiska",
+		"VI": "Jungfruöarna, amerikanska",
+		"WF": "Wallis och Futuna",
+		"ZA": "Sydafrika",
+	},
+	"tr": {
+		"AE": "Birleşik Arap Emirlikleri",
+		"AF": "Afganistan",
+		"AG": "Antigua ve Barbuda",
+		"AL": "Arnavutluk",
+		"AM": "Ermenistan",
+		"AQ": "Antarktika",
+		"AR": "Arjantin",
+		"AS": "Amerikan Samoası",
+		"AT": "Avusturya",
+		"AU": "Avustralya",
+		"AX": "Åland Adaları",
+		"AZ": "Azerbaycan",
+		"BA": "Bosna-Hersek",
+		"BD": "Bangladeş",
+		"BE": "Belçika",
+		"BG": "Bulgaristan",
+		"BH": "Bahreyn",
+		"BN": "Brunei Krallığı",
+		"BO": "Bolivya",
+		"BQ": "Bonaire, Sint Eustatius ve Saba",
+		"BR": "Brezilya",
+		"BS": "Bahamalar",
+		"BW": "Botsvana",
+		"CA": "Kanada",
+		"CC": "Cocos (Keeling) Adaları",
+		"CD": "Kongo Demokratik Cumhuriyeti",
+		"CF": "Orta Afrika Cumhuriyeti",
+		"CG": "Kongo",
+		"CH": "İsviçre",
+		"CI": "Fildişi Sahili",
+		"CK": "Cook Adaları",
+		"CL": "Şili",
+		"CM": "Kamerun",
+		"CN": "Çin",
+		"CO": "Kolombiya",
+		"CR": "Kosta Rika",
+		"CU": "Küba",
+		"CV": "Yeşil Burun Adaları",
+		"CX": "Christmas Adası",
+		"CY": "Kıbrıs",
+		"CZ": "Çekya",
+		"DE": "Almanya",
+		"DJ": "Cibuti",
+		"DK": "Danimarka",
+		"DM": "Dominika",
+		"DO": "Dominik Cumhuriyeti",
+		"DZ": "Cezayir",
+		"EC": "Ekvador",
+		"EE": "Estonya",
+		"EG": "Mısır",
+		"EH": "Batı Sahra",
+		"ER": "Eritre",
+		"ES": "İspanya",
+		"ET": "Etiyopya",
+		"FI": "Finlandiya",
+		"FK": "Falkland Adaları (Malvinas)",
+		"FM": "Mikronezya Federe Devletleri",
+		"FO": "Faroe Adaları",
+		"FR": "Fransa",
+		"GB": "Birleşik Krallık",
+		"GE": "Gürcistan",
+		"GF": "Fransız Guyanası",
+		"GH": "Gana",
+		"GI": "Cebelitarık",
+		"GL": "Grönland",
+		"GM": "Gambiya",
+		"GN": "Gine",
+		"GQ": "Ekvator Ginesi",
+		"GR": "Yunanistan",
+		"GS": "Güney Georgia ve Güney Sandwich Adaları",
+		"GW": "Gine-Bissau",
+		"HR": "Hırvatistan",
+		"HU": "Macaristan",
+		"ID": "Endonezya",
+		"IE": "İrlanda",
+		"IL": "İsrail",
+		"IM": "Man Adası",
+		"IN": "Hindistan",
+		"IO": "Britanya Hint Okyanusu Toprakları",
+		"IQ": "Irak",
+		"IR": "İran",
+		"IS": "İzlanda",
+		"IT": "İtalya",
+		"JM": "Jamaika",
+		"JO": "Ürdün",
+		"JP": "Japonya",
+		"KG": "Kırgızistan",
+		"KH": "Kamboçya",
+		"KM": "Komorlar",
+		"KN": "Saint Kitts ve Nevis",
+		"KP": "Kuzey Kore",
+		"KR": "Güney Kore",
+		"KW": "Kuveyt",
+		"KY": "Cayman Adaları",
+		"KZ": "Kazakistan",
+		"LA": "Lao Demokratik Halk Cumhuriyeti",
+		"LB": "Lübnan",
+		"LI": "Lihtenştayn",
+		"LR": "Liberya",
+		"LS": "Lesoto",
+		"LT": "Litvanya",
+		"LU": "Lüksemburg",
+		"LV": "Letonya",
+		"MA": "Fas",
+		"MC": "Monako",
+		"MD": "Moldova Cumhuriyeti",
+		"ME": "Karadağ",
+		"MF": "Saint Martin (Fransız kısmı)",
+		"MG": "Madagaskar",
+		"MH": "Marşal Adaları",
+		"MK": "Kuzey Makedonya",
+		"MN": "Moğolistan",
+		"MO": "Makao",
+		"MR": "Moritanya",
+		"MV": "Maldivler",
+		"MW": "Malavi",
+		"MX": "Meksika",
+		"MY": "Malezya",
+		"MZ": "Mozambik",
+		"NA": "Namibya",
+		"NC": "Yeni Kaledonya",
+		"NE": "Nijer",
+		"NF": "Norfolk Adası",
+		"NG": "Nijerya",
+		"NI": "Nikaragua",
+		"NL": "Hollanda",
+		"NO": "Norveç",
+		"NZ": "Yeni Zelanda",
+		"OM": "Umman",
+		"PF": "Fransız Polinezyası",
+		"PG": "Papua Yeni Gine",
+		"PH": "Filipinler",
+		"PL": "Polonya",
+		"PM": "Saint Pierre ve Miquelon",
+		"PR": "Porto Riko",
+		"PS": "Filistin Devleti",
+		"PT": "Portekiz",
+		"QA": "Katar",
+		"RO": "Romanya",
+		"RS": "Sırbistan",
+		"RU": "Rusya Federasyonu",
+		"RW": "Ruanda",
+		"SA": "Suudi Arabistan",
+		"SB": "Solomon Adaları",
+		"SC": "Seyşeller",
+		"SE": "İsveç",
+		"SG": "Singapur",
+		"SH": "Saint Helena, Ascension ve Tristan da Cunha",
+		"SI": "Slovenya",
+		"SK": "Slovakya",
+		"SO": "Somali",
+		"SR": "Surinam",
+		"SS": "Güney Sudan",
+		"ST": "Sao Tome ve Principe",
+		"SX": "Sint Maarten (Hollanda kısmı)",
+		"SY": "Suriye",
+		"TC": "Turks ve Caicos Adaları",
+		"TD": "Çad",
+		"TF": "Fransız Güney Bölgeleri",
+		"TH": "Tayland",
+		"TJ": "Tacikistan",
+		"TM": "Türkmenistan",
+		"TN": "Tunus",
+		"TT": "Trinidad ve Tobago",
+		"TW": "Tayvan",
+		"TZ": "Tanzanya",
+		"UA": "Ukrayna",
+		"UM": "Amerika Birleşik Devletleri Küçük Dış Adaları",
+		"US": "Amerika Birleşik Devletleri",
+		"UZ": "Özbekistan",
+		"VA": "Holy See (Vatikan Şehir Devleti)",
+		"VC": "Saint Vincent ve Grenadinler",
+		"VE": "Venezuela Bolivar Cumhuriyeti",
+		"VG": "İngiliz Virgin Adaları",
+		"VI": "Virgin Adaları, A.B.D.",
+		"WF": "Wallis ve Futuna Adaları",
+		"ZA": "Güney Afrika",
+		"ZM": "Zambiya",
+		"ZW": "Zimbabve",
+	},
+	"zh": {
+		"AD": "安道尔",
+		"AE": "阿联酋",
+		"AF": "阿富汗",
+		"AG": "安提瓜和巴布达",
+		"AI": "安圭拉",
+		"AL": "阿尔巴尼亚",
+		"AM": "亚美尼亚",
+		"AO": "安哥拉",
+		"AQ": "南极洲",
+		"AR": "阿根廷",
+		"AS": "美属萨摩亚",
+		"AT": "奥地利",
+		"AU": "澳大利亚",
+		"AW": "阿鲁巴",
+		"AX": "奥兰群岛",
+		"AZ": "阿塞拜疆",
+		"BA": "波斯尼亚和黑塞哥维那",
+		"BB": "巴巴多斯",
+		"BD": "孟加拉",
+		"BE": "比利时",
+		"BF": "布基纳法索",
+		"BG": "保加利亚",
+		"BH": "巴林",
+		"BI": "布隆迪",
+		"BJ": "贝宁",
+		"BL": "圣巴泰勒米岛",
+		"BM": "百慕大",
+		"BN": "文莱",
+		"BO": "波利维亚",
+		"BQ": "博奈尔、圣尤斯特歇斯岛和萨巴",
+		"BR": "巴西",
+		"BS": "巴哈马",
+		"BT": "不丹",
+		"BW": "博兹瓦那",
+		"BY": "白俄罗斯",
+		"BZ": "伯利兹",
+		"CA": "加拿大",
+		"CC": "科科斯群岛",
+		"CD": "刚果民主共和国",
+		"CF": "中非",
+		"CG": "刚果",
+		"CH": "瑞士",
+		"CI": "科特迪瓦",
+		"CK": "库克群岛",
+		"CL": "智利",
+		"CM": "喀麦隆",
+		"CN": "中国",
+		"CO": "哥伦比亚",
+		"CR": "哥斯达黎加",
+		"CU": "古巴",
+		"CV": "佛得角",
+		"CW": "库拉索",
+		"CX": "圣诞岛",
+		"CY": "塞浦路斯",
+		"CZ": "捷克",
+		"DE": "德国",
+		"DJ": "吉布提",
+		"DK": "丹麦",
+		"DM": "多米尼克",
+		"DO": "多米尼加共和国",
+		"DZ": "阿尔及利亚",
+		"EC": "厄瓜多尔",
+		"EE": "爱沙尼亚",
+		"EG": "埃及",
+		"EH": "西撒哈拉",
+		"ER": "厄立特里亚",
+		"ES": "西班牙",
+		"ET": "埃塞俄比亚",
+		"FI": "芬兰",
+		"FJ": "斐济",
+		"FK": "福克兰群岛(马尔维纳斯)",
+		"FM": "密克罗尼西亚",
+		"FO": "法罗群岛",
+		"FR": "法国",
+		"GA": "加蓬",
+		"GB": "英国",
+		"GD": "格林纳达",
+		"GE": "格鲁吉亚",
+		"GF": "法属圭亚那",
+		"GG": "根西岛",
+		"GH": "加纳",
+		"GI": "直布罗陀",
+		"GL": "格陵兰",
+		"GM": "冈比亚",
+		"GN": "几内亚",
+		"GP": "瓜德罗普",
+		"GQ": "赤道几内亚",
+		"GR": "希腊",
+		"GS": "南乔治亚岛和南桑德韦奇岛",
+		"GT": "瓜地马拉",
+		"GU": "关岛",
+		"GW": "几内亚比绍",
+		"GY": "圭亚那",
+		"HK": "香港",
+		"HN": "洪都拉斯",
+		"HR": "克罗地亚",
+		"HT": "海地",
+		"HU": "匈牙利",
+		"ID": "印度尼西亚",
+		"IE": "爱尔兰",
+		"IL": "以色列",
+		"IM": "曼岛",
+		"IN": "印度",
+		"IO": "英属印度洋领地",
+		"IQ": "伊拉克",
+		"IR": "伊朗",
+		"IS": "冰岛",
+		"IT": "意大利",
+		"JE": "泽西岛",
+		"JM": "牙买加",
+		"JO": "约旦",
+		"JP": "日本",
+		"KE": "肯尼亚",
+		"KG": "吉尔吉斯坦",
+		"KH": "柬埔塞",
+		"KI": "基里巴斯",
+		"KM": "科摩罗",
+		"KN": "圣基茨和尼维斯",
+		"KP": "朝鲜",
+		"KR": "韩国",
+		"KW": "科威特",
+		"KY": "开曼群岛",
+		"KZ": "哈萨克斯坦",
+		"LA": "老挝",
+		"LB": "黎巴嫩",
+		"LC": "圣路西亚",
+		"LI": "列支敦士登",
+		"LK": "斯里兰卡",
+		"LR": "利比里亚",
+		"LS": "莱索托",
+		"LT": "立陶宛",
+		"LU": "卢森堡",
+		"LV": "拉脱维亚",
+		"LY": "利比亚",
+		"MA": "摩洛哥",
+		"MC": "摩纳哥",
+		"MD": "摩尔多瓦",
+		"ME": "黑山",
+		"MF": "法属圣马丁",
+		"MG": "马达加斯加",
+		"MH": "马绍尔群岛",
+		"MK": "北马其顿",
+		"ML": "马里",
+		"MM": "缅甸",
+		"MN": "蒙古",
+		"MO": "澳门",
+		"MQ": "马提尼克",
+		"MR": "毛里塔尼亚",
+		"MS": "蒙塞拉特岛",
+		"MT": "马尔他",
+		"MU": "毛里求斯",
+		"MV": "马尔代夫",
+		"MW": "马拉维",
+		"MX": "墨西哥",
+		"MY": "马来西亚",
+		"MZ": "莫桑比克",
+		"NA": "纳米比亚",
+		"NC": "新喀里多尼亚",
+		"NE": "尼日尔",
+		"NF": "诺福克岛",
+		"NG": "尼日利亚",
+		"NI": "尼加拉瓜",
+		"NL": "荷兰",
+		"NO": "挪威",
+		"NP": "尼泊尔",
+		"NR": "瑙鲁",
+		"NU": "纽埃",
+		"NZ": "新西兰",
+		"OM": "阿曼",
+		"PA": "巴拿马",
+		"PE": "秘鲁",
+		"PF": "法属玻利尼西亚",
+		"PG": "巴布亚新几内亚",
+		"PH": "菲律宾",
+		"PK": "巴基斯坦",
+		"PL": "波兰",
+		"PM": "圣皮埃尔和密克隆",
+		"PN": "皮特克恩",
+		"PR": "波多黎各",
+		"PS": "巴勒斯坦",
+		"PT": "葡萄牙",
+		"PW": "帕劳",
+		"PY": "巴拉圭",
+		"QA": "卡塔尔",
+		"RE": "留尼汪",
+		"RO": "罗马尼亚",
+		"RS": "塞尔维亚",
+		"RU": "俄罗斯",
+		"RW": "卢旺达",
+		"SA": "沙特阿拉伯",
+		"SB": "所罗门群岛",
+		"SC": "塞舌尔",
+		"SD": "苏丹",
+		"SE": "瑞典",
+		"SG": "新加坡",
+		"SH": "圣赫勒拿-阿森松-特里斯坦达库尼亚",
+		"SI": "斯洛文尼亚",
+		"SK": "斯洛伐克",
+		"SL": "塞拉利昂",
+		"SM": "圣马力诺市",
+		"SN": "塞内加尔",
+		"SO": "索马里",
+		"SR": "苏里南",
+		"SS": "南苏丹",
+		"ST": "圣多美和普林西比",
+		"SV": "萨尔瓦多",
+		"SX": "荷属圣马丁",
+		"SY": "叙利亚",
+		"SZ": "斯威士兰",
+		"TC": "特克斯和凯科斯群岛",
+		"TD": "乍得",
+		"TF": "法属南半球领地",
+		"TG": "多哥",
+		"TH": "泰国",
+		"TJ": "塔吉克斯坦",
+		"TK": "托克劳",
+		"TL": "东帝汶",
+		"TM": "土库曼斯坦",
+		"TN": "突尼斯",
+		"TO": "汤加",
+		"TR": "土耳其",
+		"TT": "特里尼达和多巴哥",
+		"TV": "图瓦卢",
+		"TW": "台湾",
+		"TZ": "坦桑尼亚",
+		"UA": "乌克兰",
+		"UG": "乌干达",
+		"UM": "美国本土外小岛屿",
+		"US": "美国",
+		"UY": "乌拉圭",
+		"UZ": "乌兹别克斯坦",
+		"VA": "梵地冈",
+		"VC": "圣文森特和格林纳丁斯",
+		"VE": "委内瑞拉",
+		"VG": "英属维尔京群岛",
+		"VI": "美属维尔京群岛",
+		"VN": "越南",
+		"VU": "瓦努阿图",
+		"WF": "瓦利斯和富图纳",
+		"WS": "萨摩亚",
+		"YE": "也门",
+		"YT": "马约特",
+		"ZA": "南非",
+		"ZM": "赞比亚",
+		"ZW": "津巴布韦",
+	},
+}
@@ -25,14 +25,14 @@ var timezones = map[string]tzData{
 	"Africa/Brazzaville":   {"CG", 1},
 	"Africa/Bujumbura":     {"BI", 2},
 	"Africa/Cairo":         {"EG", 2},
-	"Africa/Casablanca":    {"MA", 1},
+	"Africa/Casablanca":    {"MA", 0},
 	"Africa/Ceuta":         {"ES", 1},
 	"Africa/Conakry":       {"GN", 0},
 	"Africa/Dakar":         {"SN", 0},
 	"Africa/Dar_es_Salaam": {"TZ", 3},
 	"Africa/Djibouti":      {"DJ", 3},
 	"Africa/Douala":        {"CM", 1},
-	"Africa/El_Aaiun":      {"EH", 1},
+	"Africa/El_Aaiun":      {"EH", 0},
 	"Africa/Freetown":      {"SL", 0},
 	"Africa/Gaborone":      {"BW", 2},
 	"Africa/Harare":        {"ZW", 2},
@@ -84,7 +84,7 @@ var timezones = map[string]tzData{
 	"America/Argentina/Tucuman":      {"AR", -3},
 	"America/Argentina/Ushuaia":      {"AR", -3},
 	"America/Aruba":                  {"AW", -4},
-	"America/Asuncion":               {"PY", -4},
+	"America/Asuncion":               {"PY", -3},
 	"America/Atikokan":               {"CA", -5},
 	"America/Bahia":                  {"BR", -3},
 	"America/Bahia_Banderas":         {"MX", -6},
@@ -213,7 +213,7 @@ var timezones = map[string]tzData{
 	"Antarctica/Casey":          {"AQ", 8},
 	"Antarctica/Davis":          {"AQ", 7},
 	"Antarctica/DumontDUrville": {"AQ", 10},
-	"Antarctica/Macquarie":      {"AU", 11},
+	"Antarctica/Macquarie":      {"AU", 10},
 	"Antarctica/Mawson":         {"AQ", 5},
 	"Antarctica/McMurdo":        {"AQ", 12},
 	"Antarctica/Palmer":         {"AQ", -3},
@@ -224,7 +224,7 @@ var timezones = map[string]tzData{
 
 	// Asia.
 	"Asia/Aden":          {"YE", 3},
-	"Asia/Almaty":        {"KZ", 6},
+	"Asia/Almaty":        {"KZ", 5},
 	"Asia/Amman":         {"JO", 3},
 	"Asia/Anadyr":        {"RU", 12},
 	"Asia/Aqtau":         {"KZ", 5},
@@ -253,7 +253,6 @@ var timezones = map[string]tzData{
 	"Asia/Hong_Kong":     {"HK", 8},
 	"Asia/Hovd":          {"MN", 7},
 	"Asia/Irkutsk":       {"RU", 8},
-	"Asia/Istanbul":      {"TR", 3},
 	"Asia/Jakarta":       {"ID", 7},
 	"Asia/Jayapura":      {"ID", 9},
 	"Asia/Jerusalem":     {"IL", 2},
@@ -281,7 +280,7 @@ var timezones = map[string]tzData{
 	"Asia/Pontianak":     {"ID", 7},
 	"Asia/Pyongyang":     {"KP", 9},
 	"Asia/Qatar":         {"QA", 3},
-	"Asia/Qostanay":      {"KZ", 6},
+	"Asia/Qostanay":      {"KZ", 5},
 	"Asia/Qyzylorda":     {"KZ", 5},
 	"Asia/Riyadh":        {"SA", 3},
 	"Asia/Sakhalin":      {"RU", 11},
@@ -346,7 +345,7 @@ var timezones = map[string]tzData{
 	"Europe/Busingen":    {"DE", 1},
 	"Europe/Chisinau":    {"MD", 2},
 	"Europe/Copenhagen":  {"DK", 1},
-	"Europe/Dublin":      {"IE", 1},
+	"Europe/Dublin":      {"IE", 0},
 	"Europe/Gibraltar":   {"GI", 1},
 	"Europe/Guernsey":    {"GG", 0},
 	"Europe/Helsinki":    {"FI", 2},
@@ -366,7 +365,6 @@ var timezones = map[string]tzData{
 	"Europe/Minsk":       {"BY", 3},
 	"Europe/Monaco":      {"MC", 1},
 	"Europe/Moscow":      {"RU", 3},
-	"Europe/Nicosia":     {"CY", 2},
 	"Europe/Oslo":        {"NO", 1},
 	"Europe/Paris":       {"FR", 1},
 	"Europe/Podgorica":   {"ME", 1},
@@ -445,7 +443,322 @@ var timezones = map[string]tzData{
 	"Pacific/Wake":         {"UM", 12},
 	"Pacific/Wallis":       {"WF", 12},
 
-	// Etc.
-	"Etc/UTC": {"", 0},
-	"UTC":     {"", 0},
+	// Etc. The sign of Etc/GMT±N is inverted: Etc/GMT+5 is five hours behind UTC.
+	"Etc/GMT":    {"", 0},
+	"Etc/GMT+1":  {"", -1},
+	"Etc/GMT+10": {"", -10},
+	"Etc/GMT+11": {"", -11},
+	"Etc/GMT+12": {"", -12},
+	"Etc/GMT+2":  {"", -2},
+	"Etc/GMT+3":  {"", -3},
+	"Etc/GMT+4":  {"", -4},
+	"Etc/GMT+5":  {"", -5},
+	"Etc/GMT+6":  {"", -6},
+	"Etc/GMT+7":  {"", -7},
+	"Etc/GMT+8":  {"", -8},
+	"Etc/GMT+9":  {"", -9},
+	"Etc/GMT-1":  {"", 1},
+	"Etc/GMT-10": {"", 10},
+	"Etc/GMT-11": {"", 11},
+	"Etc/GMT-12": {"", 12},
+	"Etc/GMT-13": {"", 13},
+	"Etc/GMT-14": {"", 14},
+	"Etc/GMT-2":  {"", 2},
+	"Etc/GMT-3":  {"", 3},
+	"Etc/GMT-4":  {"", 4},
+	"Etc/GMT-5":  {"", 5},
+	"Etc/GMT-6":  {"", 6},
+	"Etc/GMT-7":  {"", 7},
+	"Etc/GMT-8":  {"", 8},
+	"Etc/GMT-9":  {"", 9},
+	"Etc/UTC":    {"", 0},
+	"UTC":        {"", 0},
+}
+
+// rules maps identifiers of timezones that observe daylight saving time to
+// their current POSIX TZ rule, as found in the footer of the tzdata 2025b
+// compiled zone files. Africa/Casablanca and Africa/El_Aaiun, whose footers
+// carry no rule, switch to +00 during Ramadan on the 2026 dates, as LoadFS
+// derives them. Zones without an entry keep their standard offset all year.
+//
+//nolint:maintidx // Large data map is expected.
+var rules = map[string]string{
+	"Africa/Cairo":                   "EET-2EEST,M4.5.5/0,M10.5.4/24",
+	"Africa/Casablanca":              "<+00>0<+01>,80/2,45/3",
+	"Africa/Ceuta":                   "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Africa/El_Aaiun":                "<+00>0<+01>,80/2,45/3",
+	"America/Adak":                   "HST10HDT,M3.2.0,M11.1.0",
+	"America/Anchorage":              "AKST9AKDT,M3.2.0,M11.1.0",
+	"America/Boise":                  "MST7MDT,M3.2.0,M11.1.0",
+	"America/Cambridge_Bay":          "MST7MDT,M3.2.0,M11.1.0",
+	"America/Chicago":                "CST6CDT,M3.2.0,M11.1.0",
+	"America/Ciudad_Juarez":          "MST7MDT,M3.2.0,M11.1.0",
+	"America/Denver":                 "MST7MDT,M3.2.0,M11.1.0",
+	"America/Detroit":                "EST5EDT,M3.2.0,M11.1.0",
+	"America/Edmonton":               "MST7MDT,M3.2.0,M11.1.0",
+	"America/Glace_Bay":              "AST4ADT,M3.2.0,M11.1.0",
+	"America/Goose_Bay":              "AST4ADT,M3.2.0,M11.1.0",
+	"America/Grand_Turk":             "EST5EDT,M3.2.0,M11.1.0",
+	"America/Halifax":                "AST4ADT,M3.2.0,M11.1.0",
+	"America/Havana":                 "CST5CDT,M3.2.0/0,M11.1.0/1",
+	"America/Indiana/Indianapolis":   "EST5EDT,M3.2.0,M11.1.0",
+	"America/Indiana/Knox":           "CST6CDT,M3.2.0,M11.1.0",
+	"America/Indiana/Marengo":        "EST5EDT,M3.2.0,M11.1.0",
+	"America/Indiana/Petersburg":     "EST5EDT,M3.2.0,M11.1.0",
+	"America/Indiana/Tell_City":      "CST6CDT,M3.2.0,M11.1.0",
+	"America/Indiana/Vevay":          "EST5EDT,M3.2.0,M11.1.0",
+	"America/Indiana/Vincennes":      "EST5EDT,M3.2.0,M11.1.0",
+	"America/Indiana/Winamac":        "EST5EDT,M3.2.0,M11.1.0",
+	"America/Inuvik":                 "MST7MDT,M3.2.0,M11.1.0",
+	"America/Iqaluit":                "EST5EDT,M3.2.0,M11.1.0",
+	"America/Juneau":                 "AKST9AKDT,M3.2.0,M11.1.0",
+	"America/Kentucky/Louisville":    "EST5EDT,M3.2.0,M11.1.0",
+	"America/Kentucky/Monticello":    "EST5EDT,M3.2.0,M11.1.0",
+	"America/Los_Angeles":            "PST8PDT,M3.2.0,M11.1.0",
+	"America/Matamoros":              "CST6CDT,M3.2.0,M11.1.0",
+	"America/Menominee":              "CST6CDT,M3.2.0,M11.1.0",
+	"America/Metlakatla":             "AKST9AKDT,M3.2.0,M11.1.0",
+	"America/Miquelon":               "<-03>3<-02>,M3.2.0,M11.1.0",
+	"America/Moncton":                "AST4ADT,M3.2.0,M11.1.0",
+	"America/Nassau":                 "EST5EDT,M3.2.0,M11.1.0",
+	"America/New_York":               "EST5EDT,M3.2.0,M11.1.0",
+	"America/Nome":                   "AKST9AKDT,M3.2.0,M11.1.0",
+	"America/North_Dakota/Beulah":    "CST6CDT,M3.2.0,M11.1.0",
+	"America/North_Dakota/Center":    "CST6CDT,M3.2.0,M11.1.0",
+	"America/North_Dakota/New_Salem": "CST6CDT,M3.2.0,M11.1.0",
+	"America/Nuuk":                   "<-02>2<-01>,M3.5.0/-1,M10.5.0/0",
+	"America/Ojinaga":                "CST6CDT,M3.2.0,M11.1.0",
+	"America/Port-au-Prince":         "EST5EDT,M3.2.0,M11.1.0",
+	"America/Rankin_Inlet":           "CST6CDT,M3.2.0,M11.1.0",
+	"America/Resolute":               "CST6CDT,M3.2.0,M11.1.0",
+	"America/Santiago":               "<-04>4<-03>,M9.1.6/24,M4.1.6/24",
+	"America/Scoresbysund":           "<-02>2<-01>,M3.5.0/-1,M10.5.0/0",
+	"America/Sitka":                  "AKST9AKDT,M3.2.0,M11.1.0",
+	"America/St_Johns":               "NST3:30NDT,M3.2.0,M11.1.0",
+	"America/Thule":                  "AST4ADT,M3.2.0,M11.1.0",
+	"America/Tijuana":                "PST8PDT,M3.2.0,M11.1.0",
+	"America/Toronto":                "EST5EDT,M3.2.0,M11.1.0",
+	"America/Vancouver":              "PST8PDT,M3.2.0,M11.1.0",
+	"America/Winnipeg":               "CST6CDT,M3.2.0,M11.1.0",
+	"America/Yakutat":                "AKST9AKDT,M3.2.0,M11.1.0",
+	"Antarctica/Macquarie":           "AEST-10AEDT,M10.1.0,M4.1.0/3",
+	"Antarctica/McMurdo":             "NZST-12NZDT,M9.5.0,M4.1.0/3",
+	"Antarctica/Troll":               "<+00>0<+02>-2,M3.5.0/1,M10.5.0/3",
+	"Asia/Beirut":                    "EET-2EEST,M3.5.0/0,M10.5.0/0",
+	"Asia/Famagusta":                 "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Asia/Gaza":                      "EET-2EEST,M3.4.4/50,M10.4.4/50",
+	"Asia/Hebron":                    "EET-2EEST,M3.4.4/50,M10.4.4/50",
+	"Asia/Jerusalem":                 "IST-2IDT,M3.4.4/26,M10.5.0",
+	"Asia/Nicosia":                   "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Atlantic/Azores":                "<-01>1<+00>,M3.5.0/0,M10.5.0/1",
+	"Atlantic/Bermuda":               "AST4ADT,M3.2.0,M11.1.0",
+	"Atlantic/Canary":                "WET0WEST,M3.5.0/1,M10.5.0",
+	"Atlantic/Faroe":                 "WET0WEST,M3.5.0/1,M10.5.0",
+	"Atlantic/Madeira":               "WET0WEST,M3.5.0/1,M10.5.0",
+	"Australia/Adelaide":             "ACST-9:30ACDT,M10.1.0,M4.1.0/3",
+	"Australia/Broken_Hill":          "ACST-9:30ACDT,M10.1.0,M4.1.0/3",
+	"Australia/Hobart":               "AEST-10AEDT,M10.1.0,M4.1.0/3",
+	"Australia/Lord_Howe":            "<+1030>-10:30<+11>-11,M10.1.0,M4.1.0",
+	"Australia/Melbourne":            "AEST-10AEDT,M10.1.0,M4.1.0/3",
+	"Australia/Sydney":               "AEST-10AEDT,M10.1.0,M4.1.0/3",
+	"Europe/Amsterdam":               "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Andorra":                 "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Athens":                  "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Europe/Belgrade":                "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Berlin":                  "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Bratislava":              "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Brussels":                "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Bucharest":               "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Europe/Budapest":                "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Busingen":                "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Chisinau":                "EET-2EEST,M3.5.0,M10.5.0/3",
+	"Europe/Copenhagen":              "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Dublin":                  "IST-1GMT0,M10.5.0,M3.5.0/1",
+	"Europe/Gibraltar":               "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Guernsey":                "GMT0BST,M3.5.0/1,M10.5.0",
+	"Europe/Helsinki":                "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Europe/Isle_of_Man":             "GMT0BST,M3.5.0/1,M10.5.0",
+	"Europe/Jersey":                  "GMT0BST,M3.5.0/1,M10.5.0",
+	"Europe/Kyiv":                    "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Europe/Lisbon":                  "WET0WEST,M3.5.0/1,M10.5.0",
+	"Europe/Ljubljana":               "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/London":                  "GMT0BST,M3.5.0/1,M10.5.0",
+	"Europe/Luxembourg":              "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Madrid":                  "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Malta":                   "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Mariehamn":               "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Europe/Monaco":                  "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Oslo":                    "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Paris":                   "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Podgorica":               "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Prague":                  "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Riga":                    "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Europe/Rome":                    "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/San_Marino":              "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Sarajevo":                "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Skopje":                  "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Sofia":                   "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Europe/Stockholm":               "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Tallinn":                 "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Europe/Tirane":                  "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Vaduz":                   "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Vatican":                 "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Vienna":                  "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Vilnius":                 "EET-2EEST,M3.5.0/3,M10.5.0/4",
+	"Europe/Warsaw":                  "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Zagreb":                  "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Europe/Zurich":                  "CET-1CEST,M3.5.0,M10.5.0/3",
+	"Pacific/Auckland":               "NZST-12NZDT,M9.5.0,M4.1.0/3",
+	"Pacific/Chatham":                "<+1245>-12:45<+1345>,M9.5.0/2:45,M4.1.0/3:45",
+	"Pacific/Easter":                 "<-06>6<-05>,M9.1.6/22,M4.1.6/22",
+	"Pacific/Norfolk":                "<+11>-11<+12>,M10.1.0,M4.1.0/3",
+}
+
+// links maps backward-compatible alias identifiers to their canonical timezone,
+// from the tzdata 2025b "backward" file and the Etc/GMT aliases. Aliases whose
+// own zone this dataset keeps, such as Africa/Asmera for Africa/Asmara, point
+// at that zone rather than at the zone tzdata merged it into.
+//
+//nolint:maintidx // Large data map is expected.
+var links = map[string]string{
+	"Africa/Asmera":                    "Africa/Asmara",
+	"Africa/Timbuktu":                  "Africa/Bamako",
+	"America/Argentina/ComodRivadavia": "America/Argentina/Catamarca",
+	"America/Atka":                     "America/Adak",
+	"America/Buenos_Aires":             "America/Argentina/Buenos_Aires",
+	"America/Catamarca":                "America/Argentina/Catamarca",
+	"America/Coral_Harbour":            "America/Atikokan",
+	"America/Cordoba":                  "America/Argentina/Cordoba",
+	"America/Ensenada":                 "America/Tijuana",
+	"America/Fort_Wayne":               "America/Indiana/Indianapolis",
+	"America/Godthab":                  "America/Nuuk",
+	"America/Indianapolis":             "America/Indiana/Indianapolis",
+	"America/Jujuy":                    "America/Argentina/Jujuy",
+	"America/Knox_IN":                  "America/Indiana/Knox",
+	"America/Louisville":               "America/Kentucky/Louisville",
+	"America/Mendoza":                  "America/Argentina/Mendoza",
+	"America/Montreal":                 "America/Toronto",
+	"America/Nipigon":                  "America/Toronto",
+	"America/Pangnirtung":              "America/Iqaluit",
+	"America/Porto_Acre":               "America/Rio_Branco",
+	"America/Rainy_River":              "America/Winnipeg",
+	"America/Rosario":                  "America/Argentina/Cordoba",
+	"America/Santa_Isabel":             "America/Tijuana",
+	"America/Shiprock":                 "America/Denver",
+	"America/Thunder_Bay":              "America/Toronto",
+	"America/Virgin":                   "America/St_Thomas",
+	"America/Yellowknife":              "America/Edmonton",
+	"Antarctica/South_Pole":            "Antarctica/McMurdo",
+	"Arctic/Longyearbyen":              "Europe/Oslo",
+	"Asia/Ashkhabad":                   "Asia/Ashgabat",
+	"Asia/Calcutta":                    "Asia/Kolkata",
+	"Asia/Choibalsan":                  "Asia/Ulaanbaatar",
+	"Asia/Chongqing":                   "Asia/Shanghai",
+	"Asia/Chungking":                   "Asia/Shanghai",
+	"Asia/Dacca":                       "Asia/Dhaka",
+	"Asia/Harbin":                      "Asia/Shanghai",
+	"Asia/Istanbul":                    "Europe/Istanbul",
+	"Asia/Kashgar":                     "Asia/Urumqi",
+	"Asia/Katmandu":                    "Asia/Kathmandu",
+	"Asia/Macao":                       "Asia/Macau",
+	"Asia/Rangoon":                     "Asia/Yangon",
+	"Asia/Saigon":                      "Asia/Ho_Chi_Minh",
+	"Asia/Tel_Aviv":                    "Asia/Jerusalem",
+	"Asia/Thimbu":                      "Asia/Thimphu",
+	"Asia/Ujung_Pandang":               "Asia/Makassar",
+	"Asia/Ulan_Bator":                  "Asia/Ulaanbaatar",
+	"Atlantic/Faeroe":                  "Atlantic/Faroe",
+	"Atlantic/Jan_Mayen":               "Europe/Oslo",
+	"Australia/ACT":                    "Australia/Sydney",
+	"Australia/Canberra":               "Australia/Sydney",
+	"Australia/Currie":                 "Australia/Hobart",
+	"Australia/LHI":                    "Australia/Lord_Howe",
+	"Australia/NSW":                    "Australia/Sydney",
+	"Australia/North":                  "Australia/Darwin",
+	"Australia/Queensland":             "Australia/Brisbane",
+	"Australia/South":                  "Australia/Adelaide",
+	"Australia/Tasmania":               "Australia/Hobart",
+	"Australia/Victoria":               "Australia/Melbourne",
+	"Australia/West":                   "Australia/Perth",
+	"Australia/Yancowinna":             "Australia/Broken_Hill",
+	"Brazil/Acre":                      "America/Rio_Branco",
+	"Brazil/DeNoronha":                 "America/Noronha",
+	"Brazil/East":                      "America/Sao_Paulo",
+	"Brazil/West":                      "America/Manaus",
+	"Canada/Atlantic":                  "America/Halifax",
+	"Canada/Central":                   "America/Winnipeg",
+	"Canada/Eastern":                   "America/Toronto",
+	"Canada/Mountain":                  "America/Edmonton",
+	"Canada/Newfoundland":              "America/St_Johns",
+	"Canada/Pacific":                   "America/Vancouver",
+	"Canada/Saskatchewan":              "America/Regina",
+	"Canada/Yukon":                     "America/Whitehorse",
+	"Chile/Continental":                "America/Santiago",
+	"Chile/EasterIsland":               "Pacific/Easter",
+	"Cuba":                             "America/Havana",
+	"Egypt":                            "Africa/Cairo",
+	"Eire":                             "Europe/Dublin",
+	"Etc/GMT+0":                        "Etc/GMT",
+	"Etc/GMT-0":                        "Etc/GMT",
+	"Etc/GMT0":                         "Etc/GMT",
+	"Etc/Greenwich":                    "Etc/GMT",
+	"Etc/UCT":                          "Etc/UTC",
+	"Etc/Universal":                    "Etc/UTC",
+	"Etc/Zulu":                         "Etc/UTC",
+	"Europe/Belfast":                   "Europe/London",
+	"Europe/Kiev":                      "Europe/Kyiv",
+	"Europe/Nicosia":                   "Asia/Nicosia",
+	"Europe/Tiraspol":                  "Europe/Chisinau",
+	"Europe/Uzhgorod":                  "Europe/Kyiv",
+	"Europe/Zaporozhye":                "Europe/Kyiv",
+	"GB":                               "Europe/London",
+	"GB-Eire":                          "Europe/London",
+	"GMT":                              "Etc/GMT",
+	"GMT+0":                            "Etc/GMT",
+	"GMT-0":                            "Etc/GMT",
+	"GMT0":                             "Etc/GMT",
+	"Greenwich":                        "Etc/GMT",
+	"Hongkong":                         "Asia/Hong_Kong",
+	"Iceland":                          "Atlantic/Reykjavik",
+	"Iran":                             "Asia/Tehran",
+	"Israel":                           "Asia/Jerusalem",
+	"Jamaica":                          "America/Jamaica",
+	"Japan":                            "Asia/Tokyo",
+	"Kwajalein":                        "Pacific/Kwajalein",
+	"Libya":                            "Africa/Tripoli",
+	"Mexico/BajaNorte":                 "America/Tijuana",
+	"Mexico/BajaSur":                   "America/Mazatlan",
+	"Mexico/General":                   "America/Mexico_City",
+	"NZ":                               "Pacific/Auckland",
+	"NZ-CHAT":                          "Pacific/Chatham",
+	"Navajo":                           "America/Denver",
+	"PRC":                              "Asia/Shanghai",
+	"Pacific/Enderbury":                "Pacific/Kanton",
+	"Pacific/Johnston":                 "Pacific/Honolulu",
+	"Pacific/Ponape":                   "Pacific/Pohnpei",
+	"Pacific/Samoa":                    "Pacific/Pago_Pago",
+	"Pacific/Truk":                     "Pacific/Chuuk",
+	"Pacific/Yap":                      "Pacific/Chuuk",
+	"Poland":                           "Europe/Warsaw",
+	"Portugal":                         "Europe/Lisbon",
+	"ROC":                              "Asia/Taipei",
+	"ROK":                              "Asia/Seoul",
+	"Singapore":                        "Asia/Singapore",
+	"Turkey":                           "Europe/Istanbul",
+	"UCT":                              "Etc/UTC",
+	"US/Alaska":                        "America/Anchorage",
+	"US/Aleutian":                      "America/Adak",
+	"US/Arizona":                       "America/Phoenix",
+	"US/Central":                       "America/Chicago",
+	"US/East-Indiana":                  "America/Indiana/Indianapolis",
+	"US/Eastern":                       "America/New_York",
+	"US/Hawaii":                        "Pacific/Honolulu",
+	"US/Indiana-Starke":                "America/Indiana/Knox",
+	"US/Michigan":                      "America/Detroit",
+	"US/Mountain":                      "America/Denver",
+	"US/Pacific":                       "America/Los_Angeles",
+	"US/Samoa":                         "Pacific/Pago_Pago",
+	"Universal":                        "Etc/UTC",
+	"W-SU":                             "Europe/Moscow",
+	"Zulu":                             "Etc/UTC",
 }
@@ -0,0 +1,185 @@
+package tz
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ReadText returns a registry parsed from the text dataset format.
+//
+// The format is line-oriented. Blank lines and lines starting with '#' are
+// ignored. Every other line is a directive followed by whitespace-separated
+// fields:
+//
+//	version <name>
+//	zone <identifier> <country code or -> <standard UTC offset> [<POSIX TZ rule>]
+//	link <alias> <identifier>
+//
+// Offsets are given either in decimal hours ("+5.5", "-3") or as
+// "±hh:mm" ("+05:30"). The optional rule describes daylight saving time.
+// Links may appear before the zone they target. For example:
+//
+//	# Company timezone dataset.
+//	version 2026.1
+//	zone Europe/Berlin DE +01:00 CET-1CEST,M3.5.0,M10.5.0/3
+//	zone Asia/Kolkata IN +5.5
+//	zone Etc/UTC - 0
+//	link Asia/Calcutta Asia/Kolkata
+//
+// Returns ErrInvalidZone (wrapped) if the input is malformed.
+func ReadText(r io.Reader) (*Registry, error) {
+	var (
+		version string
+		zones   []ZoneData
+		links   []LinkData
+		lineNo  int
+	)
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		lineNo++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		fields := strings.Fields(line)
+
+		switch {
+		case fields[0] == "version" && len(fields) == 2:
+			version = fields[1]
+		case fields[0] == "zone" && (len(fields) == 4 || len(fields) == 5):
+			offset, err := parseOffset(fields[3])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+
+			code := fields[2]
+			if code == "-" {
+				code = ""
+			}
+
+			zone := ZoneData{Identifier: fields[1], CountryCode: code, UtcOffset: offset}
+			if len(fields) == 5 {
+				zone.Rule = fields[4]
+			}
+
+			zones = append(zones, zone)
+		case fields[0] == "link" && len(fields) == 3:
+			links = append(links, LinkData{Name: fields[1], Target: fields[2]})
+		default:
+			return nil, fmt.Errorf("line %d: malformed directive %q: %w", lineNo, line, ErrInvalidZone)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read dataset: %w", err)
+	}
+
+	reg, err := NewRegistry(zones)
+	if err != nil {
+		return nil, err
+	}
+
+	reg, err = reg.WithLinks(links...)
+	if err != nil {
+		return nil, err
+	}
+
+	reg.version = version
+
+	return reg, nil
+}
+
+// LoadFile returns a registry read from a dataset file in the text format
+// (see ReadText), the binary format (see DecodeBinary) or the JSON format
+// (see DecodeJSON). The format is detected from the file contents.
+func LoadFile(path string) (*Registry, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // Path is supplied by the caller.
+	if err != nil {
+		return nil, fmt.Errorf("read dataset: %w", err)
+	}
+
+	if bytes.HasPrefix(data, []byte(binaryMagic)) {
+		return DecodeBinary(data)
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return DecodeJSON(data)
+	}
+
+	return ReadText(bytes.NewReader(data))
+}
+
+// WriteText writes the registry in the text dataset format, with zones and
+// links sorted by identifier.
+// See ReadText for the format.
+func (r *Registry) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	if r.version != "" {
+		fmt.Fprintf(&b, "version %s\n", r.version)
+	}
+
+	for _, id := range r.All() {
+		data := r.zones[id]
+
+		code := data.countryCode
+		if code == "" {
+			code = "-"
+		}
+
+		fmt.Fprintf(&b, "zone %s %s %s", id, code, FormatOffset(data.utcOffset))
+
+		if rule := r.rules[id]; rule != "" {
+			fmt.Fprintf(&b, " %s", rule)
+		}
+
+		b.WriteByte('\n')
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(r.links)) {
+		fmt.Fprintf(&b, "link %s %s\n", name, r.links[name])
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("write dataset: %w", err)
+	}
+
+	return nil
+}
+
+// parseOffset parses a UTC offset in decimal hours ("+5.5") or "±hh:mm" form.
+func parseOffset(s string) (float32, error) {
+	hours, minutes, hasMinutes := strings.Cut(s, ":")
+
+	h, err := strconv.ParseFloat(hours, 32)
+	if err != nil || math.IsNaN(h) || math.IsInf(h, 0) {
+		return 0, fmt.Errorf("malformed offset %q: %w", s, ErrInvalidZone)
+	}
+
+	if !hasMinutes {
+		return float32(h), nil
+	}
+
+	m, err := strconv.ParseUint(minutes, 10, 8)
+	if err != nil || m >= 60 || h != math.Trunc(h) {
+		return 0, fmt.Errorf("malformed offset %q: %w", s, ErrInvalidZone)
+	}
+
+	if strings.HasPrefix(hours, "-") {
+		return float32(h - float64(m)/60), nil
+	}
+
+	return float32(h + float64(m)/60), nil
+}
@@ -0,0 +1,185 @@
+package tz
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadText(t *testing.T) {
+	t.Parallel()
+
+	const input = `# Company timezone dataset.
+version 2026.1
+
+zone Company/HQ NO +01:00
+zone Company/Lab IN +5.5
+zone Company/Ship - -09:30
+`
+
+	r, err := ReadText(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ReadText() error: %v", err)
+	}
+
+	if r.Version() != "2026.1" {
+		t.Errorf("Version() = %q, want %q", r.Version(), "2026.1")
+	}
+
+	tests := []struct {
+		identifier string
+		wantCode   string
+		wantOffset float32
+	}{
+		{"Company/HQ", "NO", 1},
+		{"Company/Lab", "IN", 5.5},
+		{"Company/Ship", "", -9.5},
+	}
+
+	for _, tt := range tests {
+		tz, err := r.Decode(tt.identifier)
+		if err != nil {
+			t.Errorf("Decode(%q) error: %v", tt.identifier, err)
+
+			continue
+		}
+
+		if tz.CountryCode() != tt.wantCode || tz.UtcOffset() != tt.wantOffset {
+			t.Errorf("Decode(%q) = %s UTC%+g, want %s UTC%+g", tt.identifier, tz.CountryCode(), tz.UtcOffset(), tt.wantCode, tt.wantOffset)
+		}
+	}
+}
+
+func TestReadTextInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "unknown directive", input: "rule Europe/Berlin DE +1\n"},
+		{name: "missing field", input: "zone Europe/Berlin DE\n"},
+		{name: "extra field", input: "zone Europe/Berlin DE +1 CET\n"},
+		{name: "bad offset", input: "zone Europe/Berlin DE one\n"},
+		{name: "bad minutes", input: "zone Europe/Berlin DE +01:75\n"},
+		{name: "fractional hours with minutes", input: "zone Europe/Berlin DE +1.5:30\n"},
+		{name: "offset out of range", input: "zone Europe/Berlin DE +15\n"},
+		{name: "bad country", input: "zone Europe/Berlin deu +1\n"},
+		{name: "bare version", input: "version\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := ReadText(strings.NewReader(tt.input)); !errors.Is(err, ErrInvalidZone) {
+				t.Errorf("ReadText() error = %v, want ErrInvalidZone", err)
+			}
+		})
+	}
+}
+
+func TestWriteTextRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	if err := Embedded().WriteText(&buf); err != nil {
+		t.Fatalf("WriteText() error: %v", err)
+	}
+
+	r, err := ReadText(&buf)
+	if err != nil {
+		t.Fatalf("ReadText() error: %v", err)
+	}
+
+	if r.Len() != len(timezones) {
+		t.Fatalf("round trip Len() = %d, want %d", r.Len(), len(timezones))
+	}
+
+	for id, data := range timezones {
+		tz, err := r.Decode(id)
+		if err != nil {
+			t.Errorf("%s: Decode() error: %v", id, err)
+
+			continue
+		}
+
+		if tz.CountryCode() != data.countryCode || tz.UtcOffset() != data.utcOffset {
+			t.Errorf("%s: round trip = %s UTC%+g, want %s UTC%+g", id, tz.CountryCode(), tz.UtcOffset(), data.countryCode, data.utcOffset)
+		}
+	}
+
+	// Equal content yields an equal digest version.
+	if r.Version() != Embedded().Version() {
+		t.Errorf("round trip Version() = %q, want %q", r.Version(), Embedded().Version())
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "zones.txt")
+
+	if err := os.WriteFile(path, []byte("zone Company/HQ NO +1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error: %v", err)
+	}
+
+	if !r.IsValid("Company/HQ") {
+		t.Error("LoadFile() registry does not contain Company/HQ")
+	}
+
+	if _, err := LoadFile(filepath.Join(t.TempDir(), "missing.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadFile() on missing file error = %v, want os.ErrNotExist", err)
+	}
+}
+
+func TestParseOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    float32
+		wantErr bool
+	}{
+		{input: "0", want: 0},
+		{input: "+1", want: 1},
+		{input: "-3.5", want: -3.5},
+		{input: "+05:45", want: 5.75},
+		{input: "-09:30", want: -9.5},
+		{input: "-00:30", want: -0.5},
+		{input: "12:00", want: 12},
+		{input: "", wantErr: true},
+		{input: "+5:60", wantErr: true},
+		{input: "+5:-1", wantErr: true},
+		{input: "NaN", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseOffset(tt.input)
+
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseOffset(%q) = %v, want error", tt.input, got)
+			}
+
+			continue
+		}
+
+		if err != nil || got != tt.want {
+			t.Errorf("parseOffset(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+		}
+
+		if back, err := parseOffset(FormatOffset(got)); err != nil || back != got {
+			t.Errorf("parseOffset(FormatOffset(%v)) = %v, %v", got, back, err)
+		}
+	}
+}
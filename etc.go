@@ -0,0 +1,88 @@
+package tz
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// militaryZones maps the NATO military time zone names to their Etc zones.
+// Juliet (J) denotes the observer's local time and has no fixed offset.
+var militaryZones = map[string]string{
+	"Alpha":    "Etc/GMT-1",
+	"Bravo":    "Etc/GMT-2",
+	"Charlie":  "Etc/GMT-3",
+	"Delta":    "Etc/GMT-4",
+	"Echo":     "Etc/GMT-5",
+	"Foxtrot":  "Etc/GMT-6",
+	"Golf":     "Etc/GMT-7",
+	"Hotel":    "Etc/GMT-8",
+	"India":    "Etc/GMT-9",
+	"Kilo":     "Etc/GMT-10",
+	"Lima":     "Etc/GMT-11",
+	"Mike":     "Etc/GMT-12",
+	"November": "Etc/GMT+1",
+	"Oscar":    "Etc/GMT+2",
+	"Papa":     "Etc/GMT+3",
+	"Quebec":   "Etc/GMT+4",
+	"Romeo":    "Etc/GMT+5",
+	"Sierra":   "Etc/GMT+6",
+	"Tango":    "Etc/GMT+7",
+	"Uniform":  "Etc/GMT+8",
+	"Victor":   "Etc/GMT+9",
+	"Whiskey":  "Etc/GMT+10",
+	"X-ray":    "Etc/GMT+11",
+	"Yankee":   "Etc/GMT+12",
+	"Zulu":     "Etc/UTC",
+}
+
+// EtcZone returns the Etc zone with the given UTC offset in hours, e.g.
+// Etc/GMT+5 for -5 and Etc/GMT-9 for 9. The sign of Etc identifiers is
+// inverted, following POSIX. A zero offset returns Etc/GMT.
+// Returns ErrNotFound (wrapped) if the offset is not a whole number of hours
+// in the range [-12, 14].
+func EtcZone(offset float32) (Timezone, error) {
+	return Default().EtcZone(offset)
+}
+
+// EtcZone returns the Etc zone in the registry with the given UTC offset in
+// hours. See the package-level EtcZone for details.
+func (r *Registry) EtcZone(offset float32) (Timezone, error) {
+	hours := int(offset)
+	if float32(hours) != offset || hours < -12 || hours > 14 {
+		return Timezone{}, fmt.Errorf("offset %v: no Etc zone: %w", offset, ErrNotFound)
+	}
+
+	id := "Etc/GMT"
+
+	// Etc/GMT+N is N hours behind UTC.
+	switch {
+	case hours > 0:
+		id += "-" + strconv.Itoa(hours)
+	case hours < 0:
+		id += "+" + strconv.Itoa(-hours)
+	}
+
+	return r.Decode(id)
+}
+
+// MilitaryZone returns the Etc zone for a military time zone, given by its
+// NATO name ("Alpha" through "Zulu") or letter ("A" through "Z"), in any case.
+// Alpha (A) is UTC+1 and Zulu (Z) is UTC. Juliet (J), the observer's local
+// time, has no zone.
+// Returns ErrNotFound (wrapped) if the name is not recognized.
+func MilitaryZone(name string) (Timezone, error) {
+	return Default().MilitaryZone(name)
+}
+
+// MilitaryZone returns the Etc zone in the registry for a military time zone.
+// See the package-level MilitaryZone for details.
+func (r *Registry) MilitaryZone(name string) (Timezone, error) {
+	for military, id := range militaryZones {
+		if strings.EqualFold(name, military) || len(name) == 1 && strings.EqualFold(name, military[:1]) {
+			return r.Decode(id)
+		}
+	}
+
+	return Timezone{}, fmt.Errorf("military zone %q: %w", name, ErrNotFound)
+}
@@ -0,0 +1,98 @@
+package tz
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEtcZone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		offset float32
+		want   string
+	}{
+		{0, "Etc/GMT"},
+		{-5, "Etc/GMT+5"},
+		{9, "Etc/GMT-9"},
+		{-12, "Etc/GMT+12"},
+		{14, "Etc/GMT-14"},
+	}
+
+	for _, tt := range tests {
+		zone, err := EtcZone(tt.offset)
+		if err != nil {
+			t.Fatalf("EtcZone(%v): unexpected error: %v", tt.offset, err)
+		}
+
+		if zone.Identifier() != tt.want {
+			t.Errorf("EtcZone(%v) = %q, want %q", tt.offset, zone.Identifier(), tt.want)
+		}
+
+		if zone.UtcOffset() != tt.offset || zone.ObservesDST() || zone.CountryCode() != "" {
+			t.Errorf("EtcZone(%v): offset %v, DST %v, country %q", tt.offset, zone.UtcOffset(), zone.ObservesDST(), zone.CountryCode())
+		}
+	}
+
+	for _, offset := range []float32{5.5, -13, 15} {
+		if _, err := EtcZone(offset); !errors.Is(err, ErrNotFound) {
+			t.Errorf("EtcZone(%v): error = %v, want ErrNotFound", offset, err)
+		}
+	}
+}
+
+func TestEtcZoneSign(t *testing.T) {
+	t.Parallel()
+
+	zone, err := Decode("Etc/GMT+5")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	instant := time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC)
+	if got := zone.In(instant).Format("15:04 MST"); got != "07:00 -05" {
+		t.Errorf("In() = %q, want %q", got, "07:00 -05")
+	}
+
+	for _, alias := range []string{"GMT", "Etc/GMT0", "Greenwich"} {
+		if zone, err := Decode(alias); err != nil || zone.Identifier() != "Etc/GMT" {
+			t.Errorf("Decode(%q) = %q, %v, want Etc/GMT", alias, zone.Identifier(), err)
+		}
+	}
+}
+
+func TestMilitaryZone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Alpha", "Etc/GMT-1"},
+		{"a", "Etc/GMT-1"},
+		{"Mike", "Etc/GMT-12"},
+		{"N", "Etc/GMT+1"},
+		{"x-ray", "Etc/GMT+11"},
+		{"Yankee", "Etc/GMT+12"},
+		{"Z", "Etc/UTC"},
+		{"zulu", "Etc/UTC"},
+	}
+
+	for _, tt := range tests {
+		zone, err := MilitaryZone(tt.name)
+		if err != nil {
+			t.Fatalf("MilitaryZone(%q): unexpected error: %v", tt.name, err)
+		}
+
+		if zone.Identifier() != tt.want {
+			t.Errorf("MilitaryZone(%q) = %q, want %q", tt.name, zone.Identifier(), tt.want)
+		}
+	}
+
+	for _, name := range []string{"J", "Juliet", "", "Alphabet"} {
+		if _, err := MilitaryZone(name); !errors.Is(err, ErrNotFound) {
+			t.Errorf("MilitaryZone(%q): error = %v, want ErrNotFound", name, err)
+		}
+	}
+}
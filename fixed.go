@@ -0,0 +1,74 @@
+package tz
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// FixedZone returns a synthetic timezone with a constant UTC offset in hours
+// and no country, for timestamps that carry an offset but no identifier. The
+// offset is rounded to the minute, and the zone is identified as
+// "UTC±hh:mm", e.g. "UTC+05:45", or as "UTC" for a zero offset. Decode and
+// Parse recognize these identifiers, so fixed zones round-trip through text,
+// JSON and SQL.
+// Returns ErrInvalidZone (wrapped) if the offset is outside [-12, 14].
+func FixedZone(offset float32) (Timezone, error) {
+	minutes := math.Round(float64(offset) * 60)
+	if minutes < minUtcOffset*60 || minutes > maxUtcOffset*60 {
+		return Timezone{}, fmt.Errorf("fixed zone: offset %v out of range [-12, 14]: %w", offset, ErrInvalidZone)
+	}
+
+	if minutes == 0 {
+		return Timezone{identifier: "UTC"}, nil
+	}
+
+	offset = float32(minutes / 60)
+
+	return Timezone{identifier: "UTC" + FormatOffset(offset), utcOffset: offset}, nil
+}
+
+// decodeFixed parses the identifier of a fixed zone as returned by FixedZone.
+// Only the canonical "UTC±hh:mm" form is accepted.
+func decodeFixed(identifier string) (Timezone, bool) {
+	const prefix = "UTC"
+
+	if len(identifier) != len(prefix)+len("+hh:mm") || identifier[:len(prefix)] != prefix {
+		return Timezone{}, false
+	}
+
+	offset, err := parseOffset(identifier[len(prefix):])
+	if err != nil {
+		return Timezone{}, false
+	}
+
+	tz, err := FixedZone(offset)
+	if err != nil || tz.identifier != identifier {
+		return Timezone{}, false
+	}
+
+	return tz, true
+}
+
+// CompatibleZones returns the zones with a country whose UTC offset at t,
+// including daylight saving time, equals that of zone, sorted by identifier.
+// For a fixed zone, these are the places a timestamp with that offset may
+// come from. Returns nil if no zones match.
+func CompatibleZones(zone Timezone, t time.Time) []Timezone {
+	return Default().CompatibleZones(zone, t)
+}
+
+// CompatibleZones returns the zones in the registry with a country whose UTC
+// offset at t equals that of zone. See the package-level CompatibleZones.
+func (r *Registry) CompatibleZones(zone Timezone, t time.Time) []Timezone {
+	result := slices.DeleteFunc(r.ByOffsetAt(zone.OffsetAt(t), t), func(tz Timezone) bool {
+		return tz.countryCode == ""
+	})
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
@@ -0,0 +1,183 @@
+package tz
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFixedZone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		offset float32
+		want   string
+		hours  float32
+	}{
+		{5.75, "UTC+05:45", 5.75},
+		{-2.5, "UTC-02:30", -2.5},
+		{-0.5, "UTC-00:30", -0.5},
+		{14, "UTC+14:00", 14},
+		{-12, "UTC-12:00", -12},
+		{0, "UTC", 0},
+		{0.001, "UTC", 0},
+		{1.0 / 3, "UTC+00:20", 1.0 / 3},
+	}
+
+	for _, tt := range tests {
+		zone, err := FixedZone(tt.offset)
+		if err != nil {
+			t.Fatalf("FixedZone(%v): unexpected error: %v", tt.offset, err)
+		}
+
+		if zone.Identifier() != tt.want || zone.UtcOffset() != tt.hours {
+			t.Errorf("FixedZone(%v) = %q %v, want %q %v", tt.offset, zone.Identifier(), zone.UtcOffset(), tt.want, tt.hours)
+		}
+
+		if _, ok := zone.Country(); ok || zone.ObservesDST() {
+			t.Errorf("FixedZone(%v): has country or DST", tt.offset)
+		}
+
+		decoded, err := Decode(zone.Identifier())
+		if err != nil {
+			t.Fatalf("Decode(%q): unexpected error: %v", zone.Identifier(), err)
+		}
+
+		if decoded != zone {
+			t.Errorf("Decode(%q) = %+v, want %+v", zone.Identifier(), decoded, zone)
+		}
+	}
+}
+
+func TestFixedZoneRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	zone, err := FixedZone(-3.5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(zone)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got Timezone
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != zone {
+		t.Errorf("round trip = %+v, want %+v", got, zone)
+	}
+
+	if !IsValid("UTC-03:30") {
+		t.Error("IsValid(\"UTC-03:30\") = false, want true")
+	}
+}
+
+func TestFixedZoneRange(t *testing.T) {
+	t.Parallel()
+
+	for _, offset := range []float32{-12.01, 14.01, 14.5, -13, 30} {
+		if _, err := FixedZone(offset); !errors.Is(err, ErrInvalidZone) {
+			t.Errorf("FixedZone(%v): error = %v, want ErrInvalidZone", offset, err)
+		}
+	}
+
+	// Decode and Parse accept exactly the identifiers FixedZone produces.
+	for _, id := range []string{"UTC-12:00", "UTC+14:00"} {
+		if _, err := Decode(id); err != nil {
+			t.Errorf("Decode(%q): unexpected error: %v", id, err)
+		}
+
+		if _, err := Parse(id); err != nil {
+			t.Errorf("Parse(%q): unexpected error: %v", id, err)
+		}
+	}
+
+	for _, id := range []string{"UTC-12:01", "UTC+14:01", "UTC+15:00", "UTC-13:00"} {
+		if _, err := Decode(id); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Decode(%q): error = %v, want ErrNotFound", id, err)
+		}
+
+		if _, err := Parse(id); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Parse(%q): error = %v, want ErrNotFound", id, err)
+		}
+	}
+}
+
+func TestFixedZoneEveryMinute(t *testing.T) {
+	t.Parallel()
+
+	instant := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for minutes := minUtcOffset * 60; minutes <= maxUtcOffset*60; minutes++ {
+		zone, err := FixedZone(float32(minutes) / 60)
+		if err != nil {
+			t.Fatalf("FixedZone(%d minutes): unexpected error: %v", minutes, err)
+		}
+
+		if _, offset := zone.In(instant).Zone(); offset != minutes*60 {
+			t.Errorf("%s: In() offset = %ds, want %ds", zone.Identifier(), offset, minutes*60)
+		}
+
+		if got := zone.Date(2026, time.January, 1, 12, 0, 0, 0); !got.Equal(instant.Add(-time.Duration(minutes) * time.Minute)) {
+			t.Errorf("%s: Date() = %v", zone.Identifier(), got)
+		}
+	}
+}
+
+func TestDecodeFixedInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, id := range []string{"UTC+00:00", "UTC-00:00", "UTC+5:45", "UTC+05:60", "UTC+24:00", "UTC 05:00", "GMT+05:00", "utc+05:00", "UTC+05:45x"} {
+		if _, err := Decode(id); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Decode(%q): error = %v, want ErrNotFound", id, err)
+		}
+	}
+}
+
+func TestCompatibleZones(t *testing.T) {
+	t.Parallel()
+
+	summer := time.Date(2026, time.July, 1, 12, 0, 0, 0, time.UTC)
+	winter := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	fixed := func(offset float32) Timezone {
+		zone, err := FixedZone(offset)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		return zone
+	}
+
+	ids := func(zones []Timezone) []string {
+		result := make([]string, len(zones))
+		for i, z := range zones {
+			result[i] = z.Identifier()
+		}
+
+		return result
+	}
+
+	if got := ids(CompatibleZones(fixed(5.75), summer)); !slices.Equal(got, []string{"Asia/Kathmandu"}) {
+		t.Errorf("CompatibleZones(UTC+05:45) = %v, want [Asia/Kathmandu]", got)
+	}
+
+	got := ids(CompatibleZones(fixed(-4), summer))
+	if !slices.Contains(got, "America/New_York") || slices.Contains(got, "Etc/GMT+4") {
+		t.Errorf("CompatibleZones(UTC-04:00) in summer = %v, want New York and no Etc zones", got)
+	}
+
+	if got := ids(CompatibleZones(fixed(-4), winter)); slices.Contains(got, "America/New_York") {
+		t.Errorf("CompatibleZones(UTC-04:00) in winter = %v, want no New York", got)
+	}
+
+	if got := CompatibleZones(fixed(-11.5), summer); got != nil {
+		t.Errorf("CompatibleZones(UTC-11:30) = %v, want nil", ids(got))
+	}
+}
@@ -0,0 +1,174 @@
+package tz
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Weights of the hints used by Guess. Every consistent zone starts at 1.
+const (
+	guessCountryWeight   = 4
+	guessLanguageWeight  = 2
+	guessPreferredWeight = 8
+)
+
+// Observation is a UTC offset observed at an instant, for example from a
+// browser's Date.getTimezoneOffset.
+type Observation struct {
+	// Time is the instant of the observation.
+	Time time.Time
+	// Offset is the UTC offset in hours east of UTC. Note that
+	// getTimezoneOffset reports minutes west of UTC: Offset = -minutes / 60.
+	Offset float32
+}
+
+// GuessHints holds the evidence used by Guess. All fields are optional.
+type GuessHints struct {
+	// Observations are offsets observed at several instants. Zones whose
+	// offsets differ from any observation are excluded. Observations in both
+	// January and July distinguish zones with and without DST.
+	Observations []Observation
+	// CountryCode is an ISO 3166-1 alpha-2 country code, e.g. from GeoIP.
+	CountryCode string
+	// Language is a BCP 47 language tag such as "pt-BR" or "de", e.g. from
+	// navigator.language. Its region subtag, or the likely region of a bare
+	// language such as "DE" for "de", is used as a weaker country hint.
+	Language string
+	// Preferred lists identifiers or aliases the caller considers likely,
+	// e.g. a previously saved setting.
+	Preferred []string
+}
+
+// Candidate is a timezone proposed by Guess.
+type Candidate struct {
+	Timezone Timezone
+	// Confidence is the candidate's share of the total score in (0, 1].
+	// The confidences of all candidates sum to 1.
+	Confidence float64
+}
+
+// Guess returns the timezones consistent with the hints, ranked by
+// confidence and then by identifier. Without observations, only zones
+// matching the country, language or preferred hints are considered.
+// Returns nil if no timezone is consistent with the hints.
+func (r *Registry) Guess(hints GuessHints) []Candidate {
+	country := strings.ToUpper(hints.CountryCode)
+	region := languageRegion(hints.Language)
+
+	preferred := make(map[string]bool, len(hints.Preferred))
+
+	for _, id := range hints.Preferred {
+		if canonical, ok := r.Canonical(id); ok {
+			preferred[canonical] = true
+		}
+	}
+
+	var (
+		candidates []Candidate
+		total      float64
+	)
+
+	for _, tz := range r.sorted {
+		if !consistent(tz, hints.Observations) {
+			continue
+		}
+
+		score := 1.0
+
+		if country != "" && tz.countryCode == country {
+			score += guessCountryWeight
+		}
+
+		if region != "" && tz.countryCode == region {
+			score += guessLanguageWeight
+		}
+
+		if preferred[tz.identifier] {
+			score += guessPreferredWeight
+		}
+
+		if len(hints.Observations) == 0 && score == 1 {
+			continue
+		}
+
+		candidates = append(candidates, Candidate{Timezone: tz, Confidence: score})
+		total += score
+	}
+
+	for i := range candidates {
+		candidates[i].Confidence /= total
+	}
+
+	slices.SortStableFunc(candidates, func(a, b Candidate) int {
+		return cmp.Compare(b.Confidence, a.Confidence)
+	})
+
+	return candidates
+}
+
+// Guess returns ranked timezone candidates from the default registry.
+// See Registry.Guess.
+func Guess(hints GuessHints) []Candidate {
+	return Default().Guess(hints)
+}
+
+// consistent reports whether the timezone's offset matches every observation.
+func consistent(tz Timezone, observations []Observation) bool {
+	for _, o := range observations {
+		if tz.OffsetAt(o.Time) != o.Offset {
+			return false
+		}
+	}
+
+	return true
+}
+
+// likelyRegions maps primary language subtags, and script subtags where
+// they decide the region, to their likely region, from the CLDR
+// likelySubtags data for the languages with localized country names.
+var likelyRegions = map[string]string{
+	"de":      "DE",
+	"en":      "US",
+	"es":      "ES",
+	"fr":      "FR",
+	"it":      "IT",
+	"ja":      "JP",
+	"ko":      "KR",
+	"nl":      "NL",
+	"pl":      "PL",
+	"pt":      "BR",
+	"ru":      "RU",
+	"sv":      "SE",
+	"tr":      "TR",
+	"zh":      "CN",
+	"zh-hans": "CN",
+	"zh-hant": "TW",
+}
+
+// languageRegion returns the upper-cased region subtag of a BCP 47 language
+// tag, e.g. "BR" for "pt-BR" or "CN" for "zh-Hans-CN". Tags without a region
+// subtag yield the likely region of their language, e.g. "DE" for "de" or
+// "TW" for "zh-Hant". Returns "" for unknown languages and for numeric
+// regions such as "es-419", which span several countries.
+func languageRegion(tag string) string {
+	subtags := strings.Split(strings.ToLower(strings.ReplaceAll(tag, "_", "-")), "-")
+
+	for _, subtag := range subtags[1:] {
+		switch {
+		case len(subtag) == 2:
+			return strings.ToUpper(subtag)
+		case len(subtag) == 3 && subtag[0] >= '0' && subtag[0] <= '9':
+			return ""
+		}
+	}
+
+	if len(subtags) > 1 && len(subtags[1]) == 4 {
+		if region, ok := likelyRegions[subtags[0]+"-"+subtags[1]]; ok {
+			return region
+		}
+	}
+
+	return likelyRegions[subtags[0]]
+}
@@ -0,0 +1,135 @@
+package tz
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGuess(t *testing.T) {
+	t.Parallel()
+
+	january := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	july := time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		hints GuessHints
+		want  string
+		count int
+	}{
+		{
+			name:  "offsets and country",
+			hints: GuessHints{Observations: []Observation{{january, 1}, {july, 2}}, CountryCode: "de"},
+			want:  "Europe/Berlin",
+		},
+		{
+			name:  "offsets and language region",
+			hints: GuessHints{Observations: []Observation{{january, -3}, {july, -3}}, Language: "pt-BR"},
+			want:  "America/Araguaina",
+		},
+		{
+			name:  "bare language",
+			hints: GuessHints{Language: "de"},
+			want:  "Europe/Berlin",
+			count: 2,
+		},
+		{
+			name:  "offsets and bare language",
+			hints: GuessHints{Observations: []Observation{{january, 9}, {july, 9}}, Language: "ja"},
+			want:  "Asia/Tokyo",
+		},
+		{
+			name:  "preferred alias",
+			hints: GuessHints{Observations: []Observation{{january, -5}, {july, -4}}, Preferred: []string{"US/Eastern"}},
+			want:  "America/New_York",
+		},
+		{
+			name:  "unique offsets",
+			hints: GuessHints{Observations: []Observation{{january, 13.75}, {july, 12.75}}},
+			want:  "Pacific/Chatham",
+			count: 1,
+		},
+		{
+			name:  "country only",
+			hints: GuessHints{CountryCode: "NZ"},
+			want:  "Pacific/Auckland",
+			count: 2,
+		},
+		{
+			name:  "inconsistent offsets",
+			hints: GuessHints{Observations: []Observation{{january, 1}, {july, 1}}, CountryCode: "DE"},
+			want:  "Africa/Algiers",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			candidates := Guess(tt.hints)
+			if len(candidates) == 0 {
+				t.Fatal("Guess() returned no candidates")
+			}
+
+			if got := candidates[0].Timezone.Identifier(); got != tt.want {
+				t.Errorf("Guess() best = %s, want %s", got, tt.want)
+			}
+
+			if tt.count > 0 && len(candidates) != tt.count {
+				t.Errorf("Guess() returned %d candidates, want %d", len(candidates), tt.count)
+			}
+
+			var sum float64
+
+			for i, c := range candidates {
+				if i > 0 && c.Confidence > candidates[i-1].Confidence {
+					t.Errorf("candidates not ranked at %s", c.Timezone.Identifier())
+				}
+
+				for _, o := range tt.hints.Observations {
+					if c.Timezone.OffsetAt(o.Time) != o.Offset {
+						t.Errorf("%s inconsistent with observation %v", c.Timezone.Identifier(), o)
+					}
+				}
+
+				sum += c.Confidence
+			}
+
+			if math.Abs(sum-1) > 1e-9 {
+				t.Errorf("confidences sum to %v, want 1", sum)
+			}
+		})
+	}
+}
+
+func TestGuessNoMatch(t *testing.T) {
+	t.Parallel()
+
+	if got := Guess(GuessHints{Observations: []Observation{{Time: time.Now(), Offset: 15}}}); got != nil {
+		t.Errorf("Guess() = %v, want nil", got)
+	}
+
+	if got := Guess(GuessHints{}); got != nil {
+		t.Errorf("Guess() without hints = %v, want nil", got)
+	}
+}
+
+func TestLanguageRegion(t *testing.T) {
+	t.Parallel()
+
+	for tag, want := range map[string]string{
+		"pt-BR": "BR", "en_us": "US", "zh-Hans-CN": "CN", "de": "DE", "JA": "JP", "pt": "BR", "zh-Hant": "TW",
+		"es-419": "", "xx": "", "": "",
+	} {
+		if got := languageRegion(tag); got != want {
+			t.Errorf("languageRegion(%q) = %q, want %q", tag, got, want)
+		}
+	}
+
+	for lang, region := range likelyRegions {
+		if _, ok := countries[region]; !ok {
+			t.Errorf("likely region %q of %q has no timezone", region, lang)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package tz
+
+import (
+	"iter"
+	"slices"
+)
+
+// The iterators below read the registry's eagerly built sorted zone list and
+// are small enough to be inlined, so ranging over them does not allocate.
+
+// Zones returns an iterator over all canonical timezones, sorted by identifier.
+func (r *Registry) Zones() iter.Seq[Timezone] {
+	return slices.Values(r.sorted)
+}
+
+// Countries returns an iterator over the ISO 3166-1 alpha-2 codes of all
+// countries with at least one timezone, sorted by code.
+func (r *Registry) Countries() iter.Seq[string] {
+	return slices.Values(r.countryCodes)
+}
+
+// ZonesInRegion returns an iterator over the timezones within region,
+// including nested sub-regions, sorted by identifier. See ByRegion.
+func (r *Registry) ZonesInRegion(region string) iter.Seq[Timezone] {
+	zones := r.sorted
+
+	return func(yield func(Timezone) bool) {
+		for _, tz := range zones {
+			if inRegion(tz.identifier, region) && !yield(tz) {
+				return
+			}
+		}
+	}
+}
+
+// ZonesInOffsetRange returns an iterator over the timezones whose standard
+// UTC offset in hours lies within [lo, hi], sorted by identifier.
+func (r *Registry) ZonesInOffsetRange(lo, hi float32) iter.Seq[Timezone] {
+	zones := r.sorted
+
+	return func(yield func(Timezone) bool) {
+		for _, tz := range zones {
+			if tz.utcOffset >= lo && tz.utcOffset <= hi && !yield(tz) {
+				return
+			}
+		}
+	}
+}
+
+// Zones returns an iterator over all canonical timezones in the default
+// registry, sorted by identifier.
+func Zones() iter.Seq[Timezone] {
+	return Default().Zones()
+}
+
+// Countries returns an iterator over the country codes of the default
+// registry, sorted by code.
+func Countries() iter.Seq[string] {
+	return Default().Countries()
+}
+
+// ZonesInRegion returns an iterator over the timezones within region in the
+// default registry, sorted by identifier.
+func ZonesInRegion(region string) iter.Seq[Timezone] {
+	return Default().ZonesInRegion(region)
+}
+
+// ZonesInOffsetRange returns an iterator over the timezones in the default
+// registry whose standard UTC offset lies within [lo, hi], sorted by identifier.
+func ZonesInOffsetRange(lo, hi float32) iter.Seq[Timezone] {
+	return Default().ZonesInOffsetRange(lo, hi)
+}
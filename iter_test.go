@@ -0,0 +1,135 @@
+package tz
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestZones(t *testing.T) {
+	t.Parallel()
+
+	var ids []string
+
+	for tz := range Zones() {
+		ids = append(ids, tz.Identifier())
+	}
+
+	if !slices.Equal(ids, All()) {
+		t.Error("Zones() does not match All()")
+	}
+
+	// Stopping early must not panic.
+	for range Zones() {
+		break
+	}
+}
+
+func TestCountries(t *testing.T) {
+	t.Parallel()
+
+	codes := slices.Collect(Countries())
+
+	if !slices.IsSorted(codes) || slices.Contains(codes, "") {
+		t.Errorf("Countries() = %v, want sorted non-empty codes", codes)
+	}
+
+	for _, code := range codes {
+		if len(ByCountryCode(code)) == 0 {
+			t.Errorf("Countries() yields %q without timezones", code)
+		}
+	}
+
+	if !slices.Contains(codes, "NO") || slices.Contains(codes, "BV") {
+		t.Error("Countries() should contain NO but not BV")
+	}
+}
+
+func TestZonesInRegion(t *testing.T) {
+	t.Parallel()
+
+	for _, region := range []string{"Europe", "America/Argentina", "Nowhere"} {
+		if got := slices.Collect(ZonesInRegion(region)); !slices.Equal(got, ByRegion(region)) {
+			t.Errorf("ZonesInRegion(%q) does not match ByRegion", region)
+		}
+	}
+}
+
+func TestZonesInOffsetRange(t *testing.T) {
+	t.Parallel()
+
+	var got []Timezone
+
+	for tz := range ZonesInOffsetRange(5.5, 6) {
+		if tz.UtcOffset() < 5.5 || tz.UtcOffset() > 6 {
+			t.Errorf("%s: offset %v outside range", tz.Identifier(), tz.UtcOffset())
+		}
+
+		got = append(got, tz)
+	}
+
+	if want := len(ByUtcOffset(5.5)) + len(ByUtcOffset(5.75)) + len(ByUtcOffset(6)); len(got) != want {
+		t.Errorf("ZonesInOffsetRange(5.5, 6) yielded %d zones, want %d", len(got), want)
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun cannot be used in parallel tests.
+func TestIteratorsAllocationFree(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are not meaningful under the race detector")
+	}
+
+	Zones()
+	ZonesInRegion("")
+
+	tests := map[string]func(){
+		"Zones": func() {
+			for range Zones() {
+			}
+		},
+		"Countries": func() {
+			for range Countries() {
+			}
+		},
+		"ZonesInRegion": func() {
+			for range ZonesInRegion("Europe") {
+			}
+		},
+		"ZonesInOffsetRange": func() {
+			for range ZonesInOffsetRange(-3, 3) {
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		if allocs := testing.AllocsPerRun(10, fn); allocs != 0 {
+			t.Errorf("%s allocates %v times per iteration", name, allocs)
+		}
+	}
+}
+
+func TestIndexSlicesNotShared(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func() []Timezone{
+		"ByCountryCode": func() []Timezone { return ByCountryCode("US") },
+		"ByUtcOffset":   func() []Timezone { return ByUtcOffset(1) },
+		"ByRegion":      func() []Timezone { return ByRegion("Europe") },
+		"Tree":          func() []Timezone { return Tree()[0].Zones },
+	}
+
+	for name, fn := range tests {
+		first := fn()
+		first[0] = Timezone{}
+
+		if fn()[0] == (Timezone{}) {
+			t.Errorf("%s returns a slice shared with its cache", name)
+		}
+	}
+}
+
+func BenchmarkZones(b *testing.B) {
+	for b.Loop() {
+		for range Zones() {
+		}
+	}
+}
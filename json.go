@@ -0,0 +1,128 @@
+package tz
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+//go:embed dataset.schema.json
+var jsonSchema []byte
+
+// JSONSchema returns the JSON Schema (draft 2020-12) describing the output of
+// Registry.MarshalJSON.
+func JSONSchema() []byte {
+	return slices.Clone(jsonSchema)
+}
+
+type jsonDataset struct {
+	Version   string        `json:"version"`
+	Zones     []jsonZone    `json:"zones"`
+	Countries []jsonCountry `json:"countries"`
+}
+
+type jsonZone struct {
+	ID      string   `json:"id"`
+	Country string   `json:"country"`
+	Offset  float32  `json:"offset"`
+	Rule    string   `json:"rule,omitempty"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+type jsonCountry struct {
+	Code    string            `json:"code"`
+	Alpha3  string            `json:"alpha3"`
+	Numeric string            `json:"numeric"`
+	Name    string            `json:"name"`
+	Names   map[string]string `json:"names,omitempty"`
+}
+
+// MarshalJSON encodes the registry as a JSON dataset conforming to JSONSchema.
+// Zones are sorted by identifier, aliases lexically and countries by code, so
+// equal registries always produce identical output.
+// It implements json.Marshaler.
+func (r *Registry) MarshalJSON() ([]byte, error) {
+	aliases := make(map[string][]string)
+
+	for _, name := range slices.Sorted(maps.Keys(r.links)) {
+		aliases[r.links[name]] = append(aliases[r.links[name]], name)
+	}
+
+	dataset := jsonDataset{Version: r.Version(), Zones: []jsonZone{}, Countries: []jsonCountry{}}
+	codes := make(map[string]bool)
+
+	for _, id := range r.All() {
+		data := r.zones[id]
+
+		dataset.Zones = append(dataset.Zones, jsonZone{
+			ID:      id,
+			Country: data.countryCode,
+			Offset:  data.utcOffset,
+			Rule:    r.rules[id],
+			Aliases: aliases[id],
+		})
+
+		if _, ok := countries[data.countryCode]; ok {
+			codes[data.countryCode] = true
+		}
+	}
+
+	for _, code := range slices.Sorted(maps.Keys(codes)) {
+		data := countries[code]
+		country := jsonCountry{Code: code, Alpha3: data.alpha3, Numeric: data.numeric, Name: data.name}
+
+		for lang, names := range countryNames {
+			if name, ok := names[code]; ok {
+				if country.Names == nil {
+					country.Names = make(map[string]string)
+				}
+
+				country.Names[lang] = name
+			}
+		}
+
+		dataset.Countries = append(dataset.Countries, country)
+	}
+
+	return json.Marshal(dataset)
+}
+
+// DecodeJSON returns a registry decoded from a JSON dataset produced by
+// Registry.MarshalJSON. Country metadata is ignored; Go registries always use
+// the package's built-in country metadata.
+// Returns ErrInvalidZone (wrapped) if the data is malformed.
+func DecodeJSON(data []byte) (*Registry, error) {
+	var dataset jsonDataset
+
+	if err := json.Unmarshal(data, &dataset); err != nil {
+		return nil, fmt.Errorf("json dataset: %w: %w", ErrInvalidZone, err)
+	}
+
+	zones := make([]ZoneData, 0, len(dataset.Zones))
+
+	var links []LinkData
+
+	for _, z := range dataset.Zones {
+		zones = append(zones, ZoneData{Identifier: z.ID, CountryCode: z.Country, UtcOffset: z.Offset, Rule: z.Rule})
+
+		for _, alias := range z.Aliases {
+			links = append(links, LinkData{Name: alias, Target: z.ID})
+		}
+	}
+
+	reg, err := NewRegistry(zones)
+	if err != nil {
+		return nil, err
+	}
+
+	reg, err = reg.WithLinks(links...)
+	if err != nil {
+		return nil, err
+	}
+
+	reg.version = dataset.Version
+
+	return reg, nil
+}
@@ -0,0 +1,166 @@
+package tz
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(Embedded())
+	if err != nil {
+		t.Fatalf("json.Marshal() error: %v", err)
+	}
+
+	r, err := DecodeJSON(data)
+	if err != nil {
+		t.Fatalf("DecodeJSON() error: %v", err)
+	}
+
+	if r.Version() != Embedded().Version() {
+		t.Errorf("Version() = %q, want %q", r.Version(), Embedded().Version())
+	}
+
+	// Output is stable.
+	again, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal() error: %v", err)
+	}
+
+	if !bytes.Equal(data, again) {
+		t.Error("re-encoded JSON dataset differs from the original")
+	}
+
+	if !reflect.DeepEqual(r.Links(), Embedded().Links()) {
+		t.Error("JSON round trip lost alias links")
+	}
+}
+
+func TestJSONContent(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(Embedded())
+	if err != nil {
+		t.Fatalf("json.Marshal() error: %v", err)
+	}
+
+	var dataset jsonDataset
+
+	if err := json.Unmarshal(data, &dataset); err != nil {
+		t.Fatalf("json.Unmarshal() error: %v", err)
+	}
+
+	if len(dataset.Zones) != len(timezones) {
+		t.Errorf("zones = %d, want %d", len(dataset.Zones), len(timezones))
+	}
+
+	i, found := slices.BinarySearchFunc(dataset.Zones, "Asia/Kolkata", func(z jsonZone, id string) int {
+		return strings.Compare(z.ID, id)
+	})
+	if !found {
+		t.Fatal("Asia/Kolkata not found in sorted zones")
+	}
+
+	if got := dataset.Zones[i]; got.Country != "IN" || got.Offset != 5.5 || !slices.Contains(got.Aliases, "Asia/Calcutta") {
+		t.Errorf("Asia/Kolkata = %+v", got)
+	}
+
+	j, found := slices.BinarySearchFunc(dataset.Countries, "DE", func(c jsonCountry, code string) int {
+		return strings.Compare(c.Code, code)
+	})
+	if !found {
+		t.Fatal("DE not found in sorted countries")
+	}
+
+	if got := dataset.Countries[j]; got.Alpha3 != "DEU" || got.Name != "Germany" || got.Names["de"] != "Deutschland" {
+		t.Errorf("DE = %+v", got)
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	var schema struct {
+		Properties map[string]any `json:"properties"`
+		Defs       map[string]struct {
+			Required   []string       `json:"required"`
+			Properties map[string]any `json:"properties"`
+		} `json:"$defs"`
+	}
+
+	if err := json.Unmarshal(JSONSchema(), &schema); err != nil {
+		t.Fatalf("JSONSchema() is not valid JSON: %v", err)
+	}
+
+	// Every JSON field emitted must be described by the schema.
+	check := func(name string, typ reflect.Type, props map[string]any) {
+		for i := range typ.NumField() {
+			tag, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+			if _, ok := props[tag]; !ok {
+				t.Errorf("schema %s does not describe field %q", name, tag)
+			}
+		}
+	}
+
+	check("dataset", reflect.TypeFor[jsonDataset](), schema.Properties)
+	check("zone", reflect.TypeFor[jsonZone](), schema.Defs["zone"].Properties)
+	check("country", reflect.TypeFor[jsonCountry](), schema.Defs["country"].Properties)
+
+	// Offsets accepted by the registry, such as +05:20, must satisfy the
+	// schema's numeric constraints.
+	offset, ok := schema.Defs["zone"].Properties["offset"].(map[string]any)
+	if !ok {
+		t.Fatal("schema zone offset is not an object")
+	}
+
+	if _, ok := offset["multipleOf"]; ok {
+		t.Error("schema zone offset requires a multiple, but the registry accepts any whole minute")
+	}
+
+	if lo, hi := offset["minimum"], offset["maximum"]; lo != float64(minUtcOffset) || hi != float64(maxUtcOffset) {
+		t.Errorf("schema zone offset range = [%v, %v], want [%d, %d]", lo, hi, minUtcOffset, maxUtcOffset)
+	}
+}
+
+func TestDecodeJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		``,
+		`[]`,
+		`{"zones": [{"id": "", "country": "", "offset": 0}]}`,
+		`{"zones": [{"id": "Company/HQ", "country": "NO", "offset": 1, "aliases": ["Company/HQ"]}]}`,
+	} {
+		if _, err := DecodeJSON([]byte(input)); !errors.Is(err, ErrInvalidZone) {
+			t.Errorf("DecodeJSON(%q) error = %v, want ErrInvalidZone", input, err)
+		}
+	}
+}
+
+func TestLoadFileJSON(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "zones.json")
+	input := `{"version": "json-1", "zones": [{"id": "Company/HQ", "country": "NO", "offset": 1, "aliases": ["HQ"]}], "countries": []}`
+
+	if err := os.WriteFile(path, []byte(input), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error: %v", err)
+	}
+
+	if r.Version() != "json-1" || !r.IsValid("HQ") {
+		t.Errorf("LoadFile() = version %q, IsValid(\"HQ\") = %v", r.Version(), r.IsValid("HQ"))
+	}
+}
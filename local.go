@@ -0,0 +1,255 @@
+package tz
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// Source identifies where the system timezone was found.
+type Source string
+
+// Sources consulted by Detector, in order of precedence.
+const (
+	SourceTZ             Source = "$TZ"
+	SourceLocaltime      Source = "/etc/localtime"
+	SourceTimezoneFile   Source = "/etc/timezone"
+	SourceSysconfigClock Source = "/etc/sysconfig/clock"
+	SourceContent        Source = "/etc/localtime content"
+	SourceLocation       Source = "time.Local"
+)
+
+// zoneinfoDirs are the compiled zoneinfo trees searched when matching TZif
+// content, relative to the file system root.
+var zoneinfoDirs = []string{"usr/share/zoneinfo", "usr/lib/zoneinfo", "usr/share/lib/zoneinfo"}
+
+// Detection is the result of system timezone detection.
+type Detection struct {
+	Timezone Timezone
+	// Source is where the timezone was found.
+	Source Source
+	// Value is the raw value read from the source, such as the $TZ value or
+	// the /etc/localtime link target.
+	Value string
+}
+
+// Detector finds the system timezone. The zero Detector inspects the host;
+// tests may substitute the file system and environment.
+type Detector struct {
+	// FS is the file system rooted at "/". Nil means the host's root.
+	// Symbolic links are resolved through fs.ReadLink.
+	FS fs.FS
+	// LookupEnv looks up environment variables. Nil means os.LookupEnv.
+	LookupEnv func(key string) (string, bool)
+	// Registry resolves identifiers. Nil means the default registry.
+	Registry *Registry
+}
+
+// Detect returns the system timezone, consulting in order:
+//
+//   - $TZ, as an identifier, ":identifier" or ":/path/to/zoneinfo/file";
+//     an empty $TZ means UTC
+//   - the /etc/localtime symbolic link into a zoneinfo tree
+//   - the identifier in /etc/timezone (Debian)
+//   - ZONE or TIMEZONE in /etc/sysconfig/clock (Red Hat, SUSE)
+//   - the zone whose zoneinfo file has the same content as /etc/localtime
+//   - the name of time.Local, if it is a known identifier
+//
+// Returns ErrNotFound (wrapped) if $TZ names an unknown zone or no source
+// yields a known zone.
+func (d Detector) Detect() (Detection, error) {
+	if value, ok := d.lookupEnv("TZ"); ok {
+		return d.fromTZ(value)
+	}
+
+	for _, detect := range []func() (Detection, bool){
+		d.fromLocaltimeLink,
+		d.fromTimezoneFile,
+		d.fromSysconfigClock,
+		d.fromLocaltimeContent,
+		d.fromLocation,
+	} {
+		if detection, ok := detect(); ok {
+			return detection, nil
+		}
+	}
+
+	return Detection{}, fmt.Errorf("system timezone: %w", ErrNotFound)
+}
+
+func (d Detector) fsys() fs.FS {
+	if d.FS == nil {
+		return os.DirFS("/")
+	}
+
+	return d.FS
+}
+
+func (d Detector) lookupEnv(key string) (string, bool) {
+	if d.LookupEnv == nil {
+		return os.LookupEnv(key)
+	}
+
+	return d.LookupEnv(key)
+}
+
+func (d Detector) registry() *Registry {
+	if d.Registry == nil {
+		return Default()
+	}
+
+	return d.Registry
+}
+
+// resolve decodes an identifier into a detection from source.
+func (d Detector) resolve(id string, source Source, value string) (Detection, bool) {
+	tz, err := d.registry().Decode(id)
+	if err != nil {
+		return Detection{}, false
+	}
+
+	return Detection{Timezone: tz, Source: source, Value: value}, true
+}
+
+func (d Detector) fromTZ(value string) (Detection, error) {
+	id := strings.TrimPrefix(value, ":")
+
+	switch {
+	case id == "":
+		id = "Etc/UTC"
+	case strings.HasPrefix(id, "/"):
+		if zone, ok := zoneinfoPath(id); ok {
+			id = zone
+		} else if zone, ok := d.matchContent(id[1:]); ok {
+			id = zone
+		}
+	}
+
+	if detection, ok := d.resolve(id, SourceTZ, value); ok {
+		return detection, nil
+	}
+
+	return Detection{}, fmt.Errorf("timezone %q from $TZ: %w", value, ErrNotFound)
+}
+
+func (d Detector) fromLocaltimeLink() (Detection, bool) {
+	target, err := fs.ReadLink(d.fsys(), "etc/localtime")
+	if err != nil {
+		return Detection{}, false
+	}
+
+	if !path.IsAbs(target) {
+		target = path.Join("/etc", target)
+	}
+
+	id, ok := zoneinfoPath(target)
+	if !ok {
+		return Detection{}, false
+	}
+
+	return d.resolve(id, SourceLocaltime, target)
+}
+
+func (d Detector) fromTimezoneFile() (Detection, bool) {
+	data, err := fs.ReadFile(d.fsys(), "etc/timezone")
+	if err != nil {
+		return Detection{}, false
+	}
+
+	id, _, _ := strings.Cut(string(data), "\n")
+	id = strings.TrimSpace(id)
+
+	return d.resolve(id, SourceTimezoneFile, id)
+}
+
+func (d Detector) fromSysconfigClock() (Detection, bool) {
+	data, err := fs.ReadFile(d.fsys(), "etc/sysconfig/clock")
+	if err != nil {
+		return Detection{}, false
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !ok || key != "ZONE" && key != "TIMEZONE" {
+			continue
+		}
+
+		id := strings.Trim(strings.TrimSpace(value), `"'`)
+
+		return d.resolve(id, SourceSysconfigClock, id)
+	}
+
+	return Detection{}, false
+}
+
+func (d Detector) fromLocaltimeContent() (Detection, bool) {
+	id, ok := d.matchContent("etc/localtime")
+	if !ok {
+		return Detection{}, false
+	}
+
+	return d.resolve(id, SourceContent, id)
+}
+
+func (d Detector) fromLocation() (Detection, bool) {
+	name := time.Local.String()
+
+	return d.resolve(name, SourceLocation, name)
+}
+
+// matchContent returns the first zone, in identifier order, whose zoneinfo
+// file has the same content as the named file.
+func (d Detector) matchContent(name string) (string, bool) {
+	fsys := d.fsys()
+
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return "", false
+	}
+
+	for _, dir := range zoneinfoDirs {
+		for _, tz := range d.registry().sorted {
+			candidate, err := fs.ReadFile(fsys, path.Join(dir, tz.identifier))
+			if err != nil {
+				continue
+			}
+
+			if bytes.Equal(candidate, data) {
+				return tz.identifier, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// zoneinfoPath extracts the identifier from a path into a zoneinfo tree, such
+// as "/usr/share/zoneinfo/Europe/Berlin" or
+// "/usr/share/zoneinfo/posix/Europe/Berlin".
+func zoneinfoPath(p string) (string, bool) {
+	i := strings.LastIndex(p, "zoneinfo/")
+	if i < 0 {
+		return "", false
+	}
+
+	id := p[i+len("zoneinfo/"):]
+
+	for _, prefix := range []string{"posix/", "right/"} {
+		id = strings.TrimPrefix(id, prefix)
+	}
+
+	return id, id != ""
+}
+
+// DetectCurrent returns the system timezone and the source it was found in.
+// See Detector.Detect.
+func DetectCurrent() (Detection, error) {
+	return Detector{}.Detect()
+}
@@ -0,0 +1,184 @@
+package tz
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	berlin := []byte("TZif2 Berlin")
+	tokyo := []byte("TZif2 Tokyo")
+
+	zoneinfo := fstest.MapFS{
+		"usr/share/zoneinfo/Europe/Berlin": {Data: berlin},
+		"usr/share/zoneinfo/Asia/Tokyo":    {Data: tokyo},
+	}
+
+	with := func(files fstest.MapFS) fstest.MapFS {
+		result := fstest.MapFS{}
+		for name, file := range zoneinfo {
+			result[name] = file
+		}
+
+		for name, file := range files {
+			result[name] = file
+		}
+
+		return result
+	}
+
+	symlink := func(target string) *fstest.MapFile {
+		return &fstest.MapFile{Data: []byte(target), Mode: fs.ModeSymlink}
+	}
+
+	tests := []struct {
+		name       string
+		env        map[string]string
+		files      fstest.MapFS
+		want       string
+		wantSource Source
+		wantValue  string
+	}{
+		{
+			name:       "TZ identifier",
+			env:        map[string]string{"TZ": "Asia/Tokyo"},
+			files:      fstest.MapFS{"etc/localtime": symlink("/usr/share/zoneinfo/Europe/Berlin")},
+			want:       "Asia/Tokyo",
+			wantSource: SourceTZ,
+			wantValue:  "Asia/Tokyo",
+		},
+		{
+			name:       "TZ colon alias",
+			env:        map[string]string{"TZ": ":US/Eastern"},
+			want:       "America/New_York",
+			wantSource: SourceTZ,
+			wantValue:  ":US/Eastern",
+		},
+		{
+			name:       "TZ zoneinfo path",
+			env:        map[string]string{"TZ": ":/usr/share/zoneinfo/posix/Europe/Berlin"},
+			want:       "Europe/Berlin",
+			wantSource: SourceTZ,
+		},
+		{
+			name:       "TZ other path",
+			env:        map[string]string{"TZ": ":/opt/tz/local"},
+			files:      fstest.MapFS{"opt/tz/local": {Data: tokyo}},
+			want:       "Asia/Tokyo",
+			wantSource: SourceTZ,
+		},
+		{
+			name:       "TZ empty",
+			env:        map[string]string{"TZ": ""},
+			want:       "Etc/UTC",
+			wantSource: SourceTZ,
+		},
+		{
+			name:       "absolute symlink",
+			files:      fstest.MapFS{"etc/localtime": symlink("/usr/share/zoneinfo/Europe/Berlin")},
+			want:       "Europe/Berlin",
+			wantSource: SourceLocaltime,
+			wantValue:  "/usr/share/zoneinfo/Europe/Berlin",
+		},
+		{
+			name:       "relative symlink",
+			files:      fstest.MapFS{"etc/localtime": symlink("../usr/share/zoneinfo/Asia/Calcutta")},
+			want:       "Asia/Kolkata",
+			wantSource: SourceLocaltime,
+			wantValue:  "/usr/share/zoneinfo/Asia/Calcutta",
+		},
+		{
+			name:       "macOS symlink",
+			files:      fstest.MapFS{"etc/localtime": symlink("/var/db/timezone/zoneinfo/America/Chicago")},
+			want:       "America/Chicago",
+			wantSource: SourceLocaltime,
+		},
+		{
+			name: "timezone file",
+			files: fstest.MapFS{
+				"etc/localtime": {Data: []byte("TZif2 unknown")},
+				"etc/timezone":  {Data: []byte("Europe/Oslo\n")},
+			},
+			want:       "Europe/Oslo",
+			wantSource: SourceTimezoneFile,
+		},
+		{
+			name:       "sysconfig clock",
+			files:      fstest.MapFS{"etc/sysconfig/clock": {Data: []byte("# comment\nUTC=true\nZONE=\"America/Denver\"\n")}},
+			want:       "America/Denver",
+			wantSource: SourceSysconfigClock,
+		},
+		{
+			name:       "content match",
+			files:      fstest.MapFS{"etc/localtime": {Data: berlin}},
+			want:       "Europe/Berlin",
+			wantSource: SourceContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := Detector{
+				FS: with(tt.files),
+				LookupEnv: func(key string) (string, bool) {
+					value, ok := tt.env[key]
+
+					return value, ok
+				},
+			}
+
+			got, err := d.Detect()
+			if err != nil {
+				t.Fatalf("Detect() error: %v", err)
+			}
+
+			if got.Timezone.Identifier() != tt.want || got.Source != tt.wantSource {
+				t.Errorf("Detect() = %s from %s, want %s from %s", got.Timezone.Identifier(), got.Source, tt.want, tt.wantSource)
+			}
+
+			if tt.wantValue != "" && got.Value != tt.wantValue {
+				t.Errorf("Detect() value = %q, want %q", got.Value, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestDetectNotFound(t *testing.T) {
+	t.Parallel()
+
+	noEnv := func(string) (string, bool) { return "", false }
+
+	d := Detector{FS: fstest.MapFS{}, LookupEnv: noEnv}
+	if got, err := d.Detect(); err == nil && got.Source != SourceLocation {
+		t.Errorf("Detect() on empty system = %+v, want error or time.Local", got)
+	}
+
+	d.LookupEnv = func(string) (string, bool) { return "Mars/Olympus_Mons", true }
+	if _, err := d.Detect(); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Detect() with unknown $TZ error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestZoneinfoPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"/usr/share/zoneinfo/Europe/Berlin":       "Europe/Berlin",
+		"/usr/share/zoneinfo/right/Europe/Berlin": "Europe/Berlin",
+		"/usr/share/zoneinfo/UTC":                 "UTC",
+		"/usr/share/zoneinfo/":                    "",
+		"/etc/localtime":                          "",
+	}
+
+	for p, want := range tests {
+		if got, _ := zoneinfoPath(p); got != want {
+			t.Errorf("zoneinfoPath(%q) = %q, want %q", p, got, want)
+		}
+	}
+}
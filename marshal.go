@@ -0,0 +1,92 @@
+package tz
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalText encodes the timezone as its identifier. The zero Timezone
+// encodes as an empty string.
+// It implements encoding.TextMarshaler.
+func (t Timezone) MarshalText() ([]byte, error) {
+	return []byte(t.identifier), nil
+}
+
+// UnmarshalText decodes a timezone identifier through Decode, resolving
+// aliases. An empty string decodes to the zero Timezone.
+// Returns ErrNotFound (wrapped) if the identifier is not recognized.
+// It implements encoding.TextUnmarshaler.
+func (t *Timezone) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = Timezone{}
+
+		return nil
+	}
+
+	tz, err := Decode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*t = tz
+
+	return nil
+}
+
+// UnmarshalJSON decodes a timezone from a JSON string holding its identifier,
+// or from the object form produced by VerboseTimezone. In both cases the
+// identifier is validated through Decode; other object fields are ignored.
+// JSON null leaves the timezone unchanged.
+// It implements json.Unmarshaler.
+func (t *Timezone) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var id string
+
+	if len(data) > 0 && data[0] == '{' {
+		var v verboseJSON
+
+		if err := json.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("timezone: %w", err)
+		}
+
+		id = v.ID
+	} else if err := json.Unmarshal(data, &id); err != nil {
+		return fmt.Errorf("timezone: %w", err)
+	}
+
+	return t.UnmarshalText([]byte(id))
+}
+
+// VerboseTimezone wraps a Timezone to encode it as a JSON object with its
+// country code and standard UTC offset instead of a bare identifier:
+//
+//	{"id":"Europe/Berlin","country":"DE","offset":1}
+//
+// Decoding accepts both forms, like Timezone.UnmarshalJSON.
+type VerboseTimezone struct {
+	Timezone
+}
+
+type verboseJSON struct {
+	ID      string  `json:"id"`
+	Country string  `json:"country"`
+	Offset  float32 `json:"offset"`
+}
+
+// Verbose returns the timezone wrapped for verbose JSON encoding.
+func (t Timezone) Verbose() VerboseTimezone {
+	return VerboseTimezone{Timezone: t}
+}
+
+// MarshalJSON encodes the timezone as a JSON object with its identifier,
+// country code and standard UTC offset.
+// It implements json.Marshaler.
+func (v VerboseTimezone) MarshalJSON() ([]byte, error) {
+	return json.Marshal(verboseJSON{ID: v.identifier, Country: v.countryCode, Offset: v.utcOffset})
+}
@@ -0,0 +1,150 @@
+package tz
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Compile-time interface checks.
+var (
+	_ encoding.TextMarshaler   = Timezone{}
+	_ encoding.TextUnmarshaler = (*Timezone)(nil)
+	_ json.Unmarshaler         = (*Timezone)(nil)
+	_ json.Marshaler           = VerboseTimezone{}
+)
+
+func TestTimezoneJSON(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Zone     Timezone  `json:"zone"`
+		Optional Timezone  `json:"optional"`
+		Pointer  *Timezone `json:"pointer"`
+	}
+
+	berlin, err := Decode("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(config{Zone: berlin})
+	if err != nil {
+		t.Fatalf("json.Marshal() error: %v", err)
+	}
+
+	if want := `{"zone":"Europe/Berlin","optional":"","pointer":null}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var got config
+
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error: %v", err)
+	}
+
+	if got.Zone != berlin || got.Optional != (Timezone{}) || got.Pointer != nil {
+		t.Errorf("json round trip = %+v", got)
+	}
+}
+
+func TestTimezoneUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "identifier", input: `"Asia/Tokyo"`, want: "Asia/Tokyo"},
+		{name: "alias", input: `"US/Eastern"`, want: "America/New_York"},
+		{name: "verbose object", input: `{"id":"Europe/Oslo","country":"NO","offset":1}`, want: "Europe/Oslo"},
+		{name: "object ignores stale fields", input: `{"id":"Europe/Oslo","offset":9}`, want: "Europe/Oslo"},
+		{name: "empty string", input: `""`, want: ""},
+		{name: "null", input: `null`, want: ""},
+		{name: "unknown identifier", input: `"Nowhere/Zone"`, wantErr: ErrNotFound},
+		{name: "unknown object identifier", input: `{"id":"Nowhere/Zone"}`, wantErr: ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tz Timezone
+
+			err := json.Unmarshal([]byte(tt.input), &tz)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("json.Unmarshal() error = %v, want %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("json.Unmarshal() error: %v", err)
+			}
+
+			if tz.Identifier() != tt.want {
+				t.Errorf("Identifier() = %q, want %q", tz.Identifier(), tt.want)
+			}
+		})
+	}
+
+	var tz Timezone
+
+	if err := json.Unmarshal([]byte(`42`), &tz); err == nil {
+		t.Error("json.Unmarshal(42) returned nil error")
+	}
+}
+
+func TestVerboseTimezone(t *testing.T) {
+	t.Parallel()
+
+	kolkata, err := Decode("Asia/Kolkata")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(kolkata.Verbose())
+	if err != nil {
+		t.Fatalf("json.Marshal() error: %v", err)
+	}
+
+	if want := `{"id":"Asia/Kolkata","country":"IN","offset":5.5}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var v VerboseTimezone
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("json.Unmarshal() error: %v", err)
+	}
+
+	if v.Timezone != kolkata {
+		t.Errorf("verbose round trip = %v, want %v", v.Timezone, kolkata)
+	}
+}
+
+// Examples.
+
+func ExampleTimezone_UnmarshalText() {
+	var cfg struct {
+		Zone Timezone `json:"zone"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"zone":"Asia/Calcutta"}`), &cfg); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(cfg.Zone.Identifier())
+
+	data, _ := json.Marshal(cfg.Zone.Verbose())
+	fmt.Println(string(data))
+	// Output:
+	// Asia/Kolkata
+	// {"id":"Asia/Kolkata","country":"IN","offset":5.5}
+}
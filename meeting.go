@@ -0,0 +1,144 @@
+package tz
+
+import (
+	"slices"
+	"time"
+)
+
+// WorkingHours describes a participant's working day in their timezone.
+type WorkingHours struct {
+	Zone Timezone
+	// Start and End are wall-clock times since local midnight, e.g.
+	// 9*time.Hour and 17*time.Hour. End may be up to 24 hours; an End
+	// before Start describes a shift ending the next day.
+	Start, End time.Duration
+	// DaysOff lists the weekdays not worked. Nil means the weekend of the
+	// zone's country; see Timezone.WeekData.
+	DaysOff []time.Weekday
+	// Holidays lists local dates not worked. Only the year, month and day of
+	// each are used; a shift is skipped if the day it starts on is a holiday.
+	Holidays []time.Time
+}
+
+// Interval is a half-open time range [Start, End).
+type Interval struct {
+	Start, End time.Time
+}
+
+// Duration returns the length of the interval.
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+// OverlappingHours returns the intervals within [from, to) during which every
+// participant is within working hours, as UTC times sorted by start. Working
+// hours follow each zone's wall clock, so overlaps shift when one zone
+// changes to or from DST; days off default to each country's weekend.
+// Returns nil if there is no overlap or no participant.
+func OverlappingHours(from, to time.Time, participants ...WorkingHours) []Interval {
+	if len(participants) == 0 || !from.Before(to) {
+		return nil
+	}
+
+	result := participants[0].intervals(from, to)
+
+	for _, p := range participants[1:] {
+		result = intersect(result, p.intervals(from, to))
+	}
+
+	return result
+}
+
+// intervals returns the participant's working intervals within [from, to).
+func (w WorkingHours) intervals(from, to time.Time) []Interval {
+	end := w.End
+	if end <= w.Start {
+		end += 24 * time.Hour
+	}
+
+	var result []Interval
+
+	// Days are local calendar dates, represented as UTC midnight. Start a
+	// day early to include shifts running past midnight.
+	first := w.Zone.In(from).AddDate(0, 0, -1)
+	last := w.Zone.In(to)
+	lastDay := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, time.UTC)
+
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC); !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		if w.dayOff(day.Weekday()) || w.holiday(day) {
+			continue
+		}
+
+		interval := Interval{
+			Start: later(w.wallClock(day, w.Start), from).UTC(),
+			End:   earlier(w.wallClock(day, end), to).UTC(),
+		}
+
+		if interval.Start.Before(interval.End) {
+			result = append(result, interval)
+		}
+	}
+
+	return result
+}
+
+// dayOff reports whether the weekday is not worked.
+func (w WorkingHours) dayOff(day time.Weekday) bool {
+	if w.DaysOff == nil {
+		return w.Zone.WeekData().IsWeekend(day)
+	}
+
+	return slices.Contains(w.DaysOff, day)
+}
+
+// holiday reports whether the date of day is one of the holidays.
+func (w WorkingHours) holiday(day time.Time) bool {
+	return slices.ContainsFunc(w.Holidays, func(h time.Time) bool {
+		y, m, d := h.Date()
+
+		return y == day.Year() && m == day.Month() && d == day.Day()
+	})
+}
+
+// wallClock returns the instant at offset since midnight of day in the zone.
+func (w WorkingHours) wallClock(day time.Time, offset time.Duration) time.Time {
+	return w.Zone.Date(day.Year(), day.Month(), day.Day(), 0, 0, int(offset/time.Second), 0)
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
+
+// intersect returns the intersection of two sorted lists of disjoint intervals.
+func intersect(a, b []Interval) []Interval {
+	var result []Interval
+
+	for len(a) > 0 && len(b) > 0 {
+		start := later(a[0].Start, b[0].Start)
+		end := earlier(a[0].End, b[0].End)
+
+		if start.Before(end) {
+			result = append(result, Interval{Start: start, End: end})
+		}
+
+		if a[0].End.Before(b[0].End) {
+			a = a[1:]
+		} else {
+			b = b[1:]
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,126 @@
+package tz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOverlappingHours(t *testing.T) {
+	t.Parallel()
+
+	zone := func(id string) Timezone {
+		z, err := Decode(id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		return z
+	}
+
+	nineToFive := func(id string) WorkingHours {
+		return WorkingHours{Zone: zone(id), Start: 9 * time.Hour, End: 17 * time.Hour}
+	}
+
+	date := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name         string
+		from, to     time.Time
+		participants []WorkingHours
+		want         []string
+	}{
+		{
+			name: "Berlin and New York in summer",
+			from: date(time.July, 6, 0), to: date(time.July, 8, 0),
+			participants: []WorkingHours{nineToFive("Europe/Berlin"), nineToFive("America/New_York")},
+			want:         []string{"2026-07-06 13:00 - 15:00", "2026-07-07 13:00 - 15:00"},
+		},
+		{
+			// Between the US and EU DST changes, the overlap grows by an hour.
+			name: "Berlin and New York in March",
+			from: date(time.March, 16, 0), to: date(time.March, 17, 0),
+			participants: []WorkingHours{nineToFive("Europe/Berlin"), nineToFive("America/New_York")},
+			want:         []string{"2026-03-16 13:00 - 16:00"},
+		},
+		{
+			name: "weekend",
+			from: date(time.July, 4, 0), to: date(time.July, 6, 0),
+			participants: []WorkingHours{nineToFive("Europe/Berlin"), nineToFive("Europe/London")},
+		},
+		{
+			name: "country weekends",
+			from: date(time.July, 3, 0), to: date(time.July, 6, 0),
+			participants: []WorkingHours{
+				nineToFive("Asia/Jerusalem"),
+				nineToFive("Asia/Riyadh"),
+			},
+			want: []string{"2026-07-05 06:00 - 14:00"},
+		},
+		{
+			name: "last local day east of UTC",
+			from: date(time.March, 10, 0), to: date(time.March, 10, 20),
+			participants: []WorkingHours{
+				{Zone: zone("Asia/Tokyo"), Start: 0, End: 8 * time.Hour},
+			},
+			want: []string{"2026-03-10 15:00 - 20:00"},
+		},
+		{
+			name: "UTC+14 across midnight",
+			from: date(time.March, 10, 0), to: date(time.March, 11, 0),
+			participants: []WorkingHours{nineToFive("Pacific/Kiritimati")},
+			want:         []string{"2026-03-10 00:00 - 03:00", "2026-03-10 19:00 - 00:00"},
+		},
+		{
+			name: "custom days off",
+			from: date(time.July, 3, 0), to: date(time.July, 6, 0),
+			participants: []WorkingHours{
+				{Zone: zone("Asia/Dubai"), Start: 9 * time.Hour, End: 18 * time.Hour, DaysOff: []time.Weekday{time.Saturday, time.Sunday}},
+				{Zone: zone("Asia/Riyadh"), Start: 9 * time.Hour, End: 17 * time.Hour, DaysOff: []time.Weekday{time.Friday, time.Saturday}},
+			},
+		},
+		{
+			name: "overnight shift",
+			from: date(time.July, 7, 0), to: date(time.July, 8, 0),
+			participants: []WorkingHours{
+				{Zone: zone("Asia/Tokyo"), Start: 9 * time.Hour, End: 18 * time.Hour},
+				{Zone: zone("America/Los_Angeles"), Start: 16 * time.Hour, End: 2 * time.Hour},
+			},
+			want: []string{"2026-07-07 00:00 - 09:00"},
+		},
+		{
+			name: "clipped to range",
+			from: date(time.July, 6, 14), to: date(time.July, 6, 20),
+			participants: []WorkingHours{nineToFive("Europe/London")},
+			want:         []string{"2026-07-06 14:00 - 16:00"},
+		},
+		{
+			name: "three zones",
+			from: date(time.July, 6, 0), to: date(time.July, 7, 0),
+			participants: []WorkingHours{nineToFive("Europe/Berlin"), nineToFive("America/New_York"), nineToFive("Asia/Kolkata")},
+		},
+		{name: "no participants", from: date(time.July, 6, 0), to: date(time.July, 7, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, i := range OverlappingHours(tt.from, tt.to, tt.participants...) {
+				got = append(got, i.Start.Format("2006-01-02 15:04")+" - "+i.End.Format("15:04"))
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("OverlappingHours() = %v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("OverlappingHours()[%d] = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package tz
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// OffsetAt returns the UTC offset in hours in effect at the given instant,
+// including daylight saving time. Offsets are computed from the zone's
+// current rules, so instants before the latest rule change may differ from
+// historical records.
+func (t Timezone) OffsetAt(instant time.Time) float32 {
+	return float32(t.offsetSeconds(instant)) / 3600
+}
+
+// ObservesDST reports whether the timezone currently observes daylight saving
+// time at any point of the year.
+func (t Timezone) ObservesDST() bool {
+	return t.rule != ""
+}
+
+// IsDST reports whether daylight saving time is in effect at the given instant.
+// Zones with negative DST, such as Europe/Dublin, report true in winter.
+func (t Timezone) IsDST(instant time.Time) bool {
+	if r := cachedRule(t.rule); r != nil {
+		return r.isDST(instant.Unix())
+	}
+
+	return false
+}
+
+// In returns the instant with its location set to a fixed zone carrying the
+// timezone's offset and abbreviation at that instant.
+func (t Timezone) In(instant time.Time) time.Time {
+	offset := t.offsetSeconds(instant)
+
+	return instant.In(time.FixedZone(t.abbreviation(instant, offset), offset))
+}
+
+func (t Timezone) offsetSeconds(instant time.Time) int {
+	if r := cachedRule(t.rule); r != nil {
+		return r.offsetAt(instant.Unix())
+	}
+
+	return t.standardSeconds()
+}
+
+// standardSeconds returns the standard UTC offset in seconds, rounded so that
+// offsets such as -8h20m, which float32 cannot represent exactly, stay whole
+// minutes.
+func (t Timezone) standardSeconds() int {
+	return int(math.Round(float64(t.utcOffset) * 3600))
+}
+
+// abbreviation returns the rule's time zone abbreviation in effect, or a
+// numeric abbreviation such as "+0530" for zones without a rule.
+func (t Timezone) abbreviation(instant time.Time, offset int) string {
+	if r := cachedRule(t.rule); r != nil {
+		name := r.stdName
+		if r.isDST(instant.Unix()) {
+			name = r.dstName
+		}
+
+		if name != "" {
+			return name
+		}
+	}
+
+	if offset == 0 {
+		return "UTC"
+	}
+
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+
+	if offset%3600 == 0 {
+		return fmt.Sprintf("%c%02d", sign, offset/3600)
+	}
+
+	return fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset%3600/60)
+}
+
+// FormatOffset formats a UTC offset in hours as "±hh:mm", rounded to the
+// minute, e.g. "+05:45" for 5.75 or "-03:30" for -3.5.
+func FormatOffset(offset float32) string {
+	sign := '+'
+
+	minutes := int(math.Round(float64(offset) * 60))
+	if minutes < 0 {
+		sign = '-'
+		minutes = -minutes
+	}
+
+	return fmt.Sprintf("%c%02d:%02d", sign, minutes/60, minutes%60)
+}
+
+// OffsetGroup is a set of timezones sharing a UTC offset at an instant.
+type OffsetGroup struct {
+	// Offset is the UTC offset in hours.
+	Offset float32
+	// Zones holds the timezones with the offset, sorted by identifier.
+	Zones []Timezone
+}
+
+// ByOffsetAt returns all timezones whose UTC offset in hours at instant t,
+// including daylight saving time, equals offset. Unlike ByUtcOffset, UTC+2 in
+// July matches Europe/Berlin.
+// Results are sorted by identifier. Returns nil if no timezones match.
+func (r *Registry) ByOffsetAt(offset float32, t time.Time) []Timezone {
+	var result []Timezone
+
+	for _, tz := range r.sorted {
+		if tz.OffsetAt(t) == offset {
+			result = append(result, tz)
+		}
+	}
+
+	return result
+}
+
+// GroupByOffsetAt groups all timezones by their UTC offset at instant t,
+// including daylight saving time. Groups are sorted by offset.
+func (r *Registry) GroupByOffsetAt(t time.Time) []OffsetGroup {
+	var groups []OffsetGroup
+
+	index := make(map[float32]int)
+
+	for _, tz := range r.sorted {
+		offset := tz.OffsetAt(t)
+
+		i, ok := index[offset]
+		if !ok {
+			i = len(groups)
+			index[offset] = i
+			groups = append(groups, OffsetGroup{Offset: offset})
+		}
+
+		groups[i].Zones = append(groups[i].Zones, tz)
+	}
+
+	slices.SortFunc(groups, func(a, b OffsetGroup) int {
+		return cmp.Compare(a.Offset, b.Offset)
+	})
+
+	return groups
+}
+
+// ByOffsetAt returns all timezones in the default registry whose UTC offset at
+// instant t equals offset. See Registry.ByOffsetAt.
+func ByOffsetAt(offset float32, t time.Time) []Timezone {
+	return Default().ByOffsetAt(offset, t)
+}
+
+// GroupByOffsetAt groups all timezones in the default registry by their UTC
+// offset at instant t. See Registry.GroupByOffsetAt.
+func GroupByOffsetAt(t time.Time) []OffsetGroup {
+	return Default().GroupByOffsetAt(t)
+}
+
+// Date returns the instant at the given wall-clock time in the timezone, like
+// time.Date. A wall-clock time that occurs twice when clocks are set back
+// resolves to the first occurrence; one skipped when clocks are set forward
+// is interpreted with the offset in effect before the transition, so 02:30 on
+// the day New York springs forward yields 03:30 EDT.
+func (t Timezone) Date(year int, month time.Month, day, hour, minute, sec, nsec int) time.Time {
+	wall := time.Date(year, month, day, hour, minute, sec, nsec, time.UTC)
+
+	// Larger offsets give earlier instants, so they are tried first.
+	offsets := []int{t.standardSeconds()}
+	if r := cachedRule(t.rule); r != nil {
+		offsets = []int{max(r.stdOffset, r.dstOffset), min(r.stdOffset, r.dstOffset)}
+	}
+
+	for _, offset := range offsets {
+		if instant := wall.Add(-time.Duration(offset) * time.Second); t.offsetSeconds(instant) == offset {
+			return t.In(instant)
+		}
+	}
+
+	// Skipped wall-clock time.
+	offset := t.offsetSeconds(wall.Add(-time.Duration(offsets[0]) * time.Second))
+
+	return t.In(wall.Add(-time.Duration(offset) * time.Second))
+}
+
+// Transition is a change of UTC offset in a timezone.
+type Transition struct {
+	// At is the instant of the change, in the timezone's local time after
+	// the change.
+	At time.Time
+	// Offset is the UTC offset in hours from At.
+	Offset float32
+	// Abbreviation is the time zone abbreviation from At, e.g. "CEST".
+	Abbreviation string
+	// DST reports whether daylight saving time is in effect from At.
+	DST bool
+}
+
+// Transitions returns the daylight saving time transitions in the given year
+// under the zone's current rules, sorted by time. Returns nil for zones that
+// do not observe DST.
+func (t Timezone) Transitions(year int) []Transition {
+	r := cachedRule(t.rule)
+	if r == nil {
+		return nil
+	}
+
+	start, end := r.transitions(year)
+
+	result := []Transition{t.transition(time.Unix(start, 0)), t.transition(time.Unix(end, 0))}
+	if end < start {
+		result[0], result[1] = result[1], result[0]
+	}
+
+	return result
+}
+
+func (t Timezone) transition(instant time.Time) Transition {
+	local := t.In(instant)
+	name, offset := local.Zone()
+
+	return Transition{At: local, Offset: float32(offset) / 3600, Abbreviation: name, DST: t.IsDST(instant)}
+}
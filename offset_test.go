@@ -0,0 +1,288 @@
+package tz
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestOffsetAt(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id      string
+		instant time.Time
+		want    float32
+		wantDST bool
+	}{
+		{"Europe/Berlin", time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC), 1, false},
+		{"Europe/Berlin", time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC), 2, true},
+		{"Europe/Berlin", time.Date(2025, 3, 30, 0, 59, 59, 0, time.UTC), 1, false},
+		{"Europe/Berlin", time.Date(2025, 3, 30, 1, 0, 0, 0, time.UTC), 2, true},
+		{"Europe/Berlin", time.Date(2025, 10, 26, 0, 59, 59, 0, time.UTC), 2, true},
+		{"Europe/Berlin", time.Date(2025, 10, 26, 1, 0, 0, 0, time.UTC), 1, false},
+		{"America/New_York", time.Date(2025, 7, 4, 16, 0, 0, 0, time.UTC), -4, true},
+		{"America/St_Johns", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), -3.5, false},
+		{"Australia/Sydney", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 11, true},
+		{"Australia/Sydney", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), 10, false},
+		{"Australia/Lord_Howe", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 11, true},
+		{"Asia/Tokyo", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), 9, false},
+		{"Asia/Kathmandu", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), 5.75, false},
+		{"Europe/Dublin", time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC), 0, true},
+		{"Europe/Dublin", time.Date(2026, 7, 15, 12, 0, 0, 0, time.UTC), 1, false},
+		{"Africa/Casablanca", time.Date(2026, 2, 15, 1, 59, 59, 0, time.UTC), 1, true},
+		{"Africa/Casablanca", time.Date(2026, 2, 15, 2, 0, 0, 0, time.UTC), 0, false},
+		{"Africa/Casablanca", time.Date(2026, 3, 22, 2, 0, 0, 0, time.UTC), 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id+" "+tt.instant.Format(time.RFC3339), func(t *testing.T) {
+			t.Parallel()
+
+			zone, err := Decode(tt.id)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := zone.OffsetAt(tt.instant); got != tt.want {
+				t.Errorf("OffsetAt() = %v, want %v", got, tt.want)
+			}
+
+			if got := zone.IsDST(tt.instant); got != tt.wantDST {
+				t.Errorf("IsDST() = %v, want %v", got, tt.wantDST)
+			}
+		})
+	}
+}
+
+func TestFormatOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := map[float32]string{0: "+00:00", 5.75: "+05:45", -3.5: "-03:30", 14: "+14:00", -12: "-12:00", float32(-500) / 60: "-08:20", -0.5: "-00:30"}
+
+	for offset, want := range tests {
+		if got := FormatOffset(offset); got != want {
+			t.Errorf("FormatOffset(%v) = %q, want %q", offset, got, want)
+		}
+	}
+}
+
+func TestOffsetAtMatchesZoneinfo(t *testing.T) {
+	t.Parallel()
+
+	if _, err := time.LoadLocation("Europe/Berlin"); err != nil {
+		t.Skipf("system zoneinfo not available: %v", err)
+	}
+
+	// Palestinian DST is suspended during Ramadan, and Moroccan time falls
+	// back to +00 for it, which zoneinfo records as explicit transitions that
+	// a POSIX rule can express for one year only.
+	divergent := map[string]bool{"Asia/Gaza": true, "Asia/Hebron": true, "Africa/Casablanca": true, "Africa/El_Aaiun": true}
+
+	for _, id := range All() {
+		if divergent[id] {
+			continue
+		}
+
+		zone, _ := Decode(id)
+
+		loc, err := time.LoadLocation(id)
+		if err != nil {
+			continue
+		}
+
+		// Compare every six hours over three years of current rules.
+		start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		for instant := start; instant.Before(end); instant = instant.Add(6 * time.Hour) {
+			_, want := instant.In(loc).Zone()
+
+			if got := zone.In(instant); got.Unix() != instant.Unix() || offsetOf(got) != want {
+				if zone.rule == "" && offsetOf(got) == zone.standardSeconds() {
+					// Host zoneinfo may predate a rule change in the dataset.
+					break
+				}
+
+				t.Errorf("%s at %s: offset %d, zoneinfo %d", id, instant.Format(time.RFC3339), offsetOf(got), want)
+
+				break
+			}
+		}
+	}
+}
+
+func offsetOf(t time.Time) int {
+	_, offset := t.Zone()
+
+	return offset
+}
+
+func TestIn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{"Europe/Berlin", "2025-07-01 14:00 CEST"},
+		{"Asia/Kolkata", "2025-07-01 17:30 +0530"},
+		{"Asia/Tokyo", "2025-07-01 21:00 +09"},
+		{"Etc/UTC", "2025-07-01 12:00 UTC"},
+		{"Australia/Lord_Howe", "2025-07-01 22:30 +1030"},
+	}
+
+	instant := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			zone, err := Decode(tt.id)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := zone.In(instant).Format("2006-01-02 15:04 MST"); got != tt.want {
+				t.Errorf("In() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkOffsetAt(b *testing.B) {
+	zone, _ := Decode("Europe/Berlin")
+	instant := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	for b.Loop() {
+		zone.OffsetAt(instant)
+	}
+}
+
+func TestByOffsetAt(t *testing.T) {
+	t.Parallel()
+
+	july := time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC)
+	january := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	ids := func(zones []Timezone) []string {
+		var result []string
+		for _, zone := range zones {
+			result = append(result, zone.Identifier())
+		}
+
+		return result
+	}
+
+	summer := ids(ByOffsetAt(2, july))
+	if !slices.Contains(summer, "Europe/Berlin") || !slices.Contains(summer, "Europe/Paris") || !slices.Contains(summer, "Africa/Johannesburg") {
+		t.Errorf("ByOffsetAt(2, July) = %v, missing central European zones", summer)
+	}
+
+	if !slices.IsSorted(summer) {
+		t.Error("ByOffsetAt() results are not sorted")
+	}
+
+	if winter := ids(ByOffsetAt(2, january)); slices.Contains(winter, "Europe/Berlin") || !slices.Contains(winter, "Europe/Helsinki") {
+		t.Errorf("ByOffsetAt(2, January) = %v", winter)
+	}
+
+	if got := ByOffsetAt(15, july); got != nil {
+		t.Errorf("ByOffsetAt(15) = %v, want nil", got)
+	}
+}
+
+func TestGroupByOffsetAt(t *testing.T) {
+	t.Parallel()
+
+	instant := time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC)
+	groups := GroupByOffsetAt(instant)
+
+	total := 0
+
+	for i, group := range groups {
+		if i > 0 && groups[i-1].Offset >= group.Offset {
+			t.Errorf("groups not sorted at %v", group.Offset)
+		}
+
+		if !slices.Equal(group.Zones, ByOffsetAt(group.Offset, instant)) {
+			t.Errorf("group %v does not match ByOffsetAt", group.Offset)
+		}
+
+		total += len(group.Zones)
+	}
+
+	if total != len(All()) {
+		t.Errorf("groups hold %d timezones, want %d", total, len(All()))
+	}
+}
+
+func TestDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id   string
+		wall time.Time
+		want string
+	}{
+		{"America/New_York", time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC), "2026-07-01T16:00:00Z"},
+		{"America/New_York", time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC), "2026-01-01T17:00:00Z"},
+		// Repeated hour resolves to the first (DST) occurrence.
+		{"America/New_York", time.Date(2026, 11, 1, 1, 30, 0, 0, time.UTC), "2026-11-01T05:30:00Z"},
+		// Skipped hour uses the offset before the transition.
+		{"America/New_York", time.Date(2026, 3, 8, 2, 30, 0, 0, time.UTC), "2026-03-08T07:30:00Z"},
+		{"Europe/Dublin", time.Date(2026, 3, 29, 1, 30, 0, 0, time.UTC), "2026-03-29T01:30:00Z"},
+		{"Australia/Lord_Howe", time.Date(2026, 4, 5, 1, 45, 0, 0, time.UTC), "2026-04-04T14:45:00Z"},
+		{"Asia/Kathmandu", time.Date(2026, 7, 1, 5, 45, 0, 0, time.UTC), "2026-07-01T00:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id+" "+tt.wall.Format(time.DateTime), func(t *testing.T) {
+			t.Parallel()
+
+			zone, err := Decode(tt.id)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			w := tt.wall
+			if got := zone.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), 0, 0).UTC().Format(time.RFC3339); got != tt.want {
+				t.Errorf("Date() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransitions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id   string
+		want []string
+	}{
+		{"Europe/London", []string{"2026-03-29T02:00:00+01:00 BST true", "2026-10-25T01:00:00Z GMT false"}},
+		{"Australia/Sydney", []string{"2026-04-05T02:00:00+10:00 AEST false", "2026-10-04T03:00:00+11:00 AEDT true"}},
+		{"Asia/Tokyo", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			zone, err := Decode(tt.id)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, tr := range zone.Transitions(2026) {
+				got = append(got, fmt.Sprintf("%s %s %v", tr.At.Format(time.RFC3339), tr.Abbreviation, tr.DST))
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Transitions(2026) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package tz
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the maximum number of close matches returned by Suggest.
+const maxSuggestions = 3
+
+// Parse parses a user-supplied timezone, as typically given on a command line.
+// It accepts, in order of precedence:
+//
+//   - "local" (any case), resolved through Current
+//   - fixed offsets "UTC±hh:mm" or "UTC±h", e.g. "UTC+05:30"
+//   - identifiers and aliases recognized by Decode
+//   - identifiers and aliases differing only in case, e.g. "europe/berlin"
+//
+// Returns ErrNotFound (wrapped) if the input is not recognized; the error
+// message lists close matches from Suggest.
+func Parse(s string) (Timezone, error) {
+	s = strings.TrimSpace(s)
+
+	if strings.EqualFold(s, "local") {
+		return Current()
+	}
+
+	if tz, ok := parseFixed(s); ok {
+		return tz, nil
+	}
+
+	reg := Default()
+
+	if tz, err := reg.Decode(s); err == nil {
+		return tz, nil
+	}
+
+	var match string
+
+	for _, id := range reg.identifiers() {
+		if strings.EqualFold(id, s) {
+			if match != "" {
+				match = ""
+
+				break
+			}
+
+			match = id
+		}
+	}
+
+	if match != "" {
+		return reg.Decode(match)
+	}
+
+	if suggestions := reg.Suggest(s); len(suggestions) > 0 {
+		return Timezone{}, fmt.Errorf("timezone %q: %w (did you mean %s?)", s, ErrNotFound, quoteList(suggestions))
+	}
+
+	return Timezone{}, fmt.Errorf("timezone %q: %w", s, ErrNotFound)
+}
+
+// parseFixed parses "UTC±hh:mm" into a fixed-offset timezone. "GMT±h" is not
+// accepted, as Etc/GMT±h zones use the inverted POSIX sign.
+func parseFixed(s string) (Timezone, bool) {
+	if len(s) < 5 || !strings.EqualFold(s[:3], "UTC") {
+		return Timezone{}, false
+	}
+
+	if s[3] != '+' && s[3] != '-' {
+		return Timezone{}, false
+	}
+
+	offset, err := parseOffset(s[3:])
+	if err != nil {
+		return Timezone{}, false
+	}
+
+	tz, err := FixedZone(offset)
+
+	return tz, err == nil
+}
+
+func quoteList(items []string) string {
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = fmt.Sprintf("%q", item)
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// identifiers returns all canonical and alias identifiers in the registry.
+func (r *Registry) identifiers() []string {
+	ids := make([]string, 0, len(r.zones)+len(r.links))
+
+	for id := range r.zones {
+		ids = append(ids, id)
+	}
+
+	for id := range r.links {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// Suggest returns up to three identifiers closest to s, sorted by identifier,
+// for use in error messages. Matching is case-insensitive and considers both
+// the full identifier and its last segment, so "berln" suggests
+// "Europe/Berlin". Only the closest matches are returned.
+func (r *Registry) Suggest(s string) []string {
+	needle := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), " ", "_"))
+	if needle == "" {
+		return nil
+	}
+
+	// Allow roughly one edit per three characters, and at least two.
+	limit := max(2, len(needle)/3)
+
+	type candidate struct {
+		id       string
+		distance int
+	}
+
+	var candidates []candidate
+
+	for _, id := range r.identifiers() {
+		lower := strings.ToLower(id)
+		distance := levenshtein(needle, lower)
+
+		if i := strings.LastIndexByte(lower, '/'); i >= 0 {
+			distance = min(distance, levenshtein(needle, lower[i+1:]))
+		}
+
+		if distance <= limit {
+			candidates = append(candidates, candidate{id: id, distance: distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+
+		return candidates[i].id < candidates[j].id
+	})
+
+	var result []string
+
+	for _, c := range candidates {
+		if c.distance > candidates[0].distance || len(result) == maxSuggestions {
+			break
+		}
+
+		result = append(result, c.id)
+	}
+
+	return result
+}
+
+// Suggest returns up to three identifiers close to s from the default registry.
+// See Registry.Suggest.
+func Suggest(s string) []string {
+	return Default().Suggest(s)
+}
+
+// levenshtein returns the edit distance between two ASCII strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// String returns the timezone identifier.
+// It implements fmt.Stringer and, with Set, flag.Value.
+func (t Timezone) String() string {
+	return t.identifier
+}
+
+// Set parses a command-line value through Parse.
+// It implements flag.Value, so a Timezone can be registered with flag.Var.
+func (t *Timezone) Set(value string) error {
+	tz, err := Parse(value)
+	if err != nil {
+		return err
+	}
+
+	*t = tz
+
+	return nil
+}
+
+// Type returns the value type name shown in help output by pflag-compatible
+// flag libraries.
+func (t *Timezone) Type() string {
+	return "timezone"
+}
@@ -0,0 +1,185 @@
+package tz
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var _ flag.Value = (*Timezone)(nil)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input      string
+		want       string
+		wantCode   string
+		wantOffset float32
+	}{
+		{input: "Europe/Berlin", want: "Europe/Berlin", wantCode: "DE", wantOffset: 1},
+		{input: "  Europe/Berlin  ", want: "Europe/Berlin", wantCode: "DE", wantOffset: 1},
+		{input: "US/Eastern", want: "America/New_York", wantCode: "US", wantOffset: -5},
+		{input: "europe/berlin", want: "Europe/Berlin", wantCode: "DE", wantOffset: 1},
+		{input: "ASIA/CALCUTTA", want: "Asia/Kolkata", wantCode: "IN", wantOffset: 5.5},
+		{input: "UTC", want: "UTC"},
+		{input: "UTC+05:30", want: "UTC+05:30", wantOffset: 5.5},
+		{input: "utc-3", want: "UTC-03:00", wantOffset: -3},
+		{input: "Etc/GMT-3", want: "Etc/GMT-3", wantOffset: 3},
+		{input: "GMT", want: "Etc/GMT"},
+		{input: "UTC-09:30", want: "UTC-09:30", wantOffset: -9.5},
+		{input: "UTC+00:00", want: "UTC"},
+		{input: "UTC+14", want: "UTC+14:00", wantOffset: 14},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			tz, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+
+			if tz.Identifier() != tt.want || tz.CountryCode() != tt.wantCode || tz.UtcOffset() != tt.wantOffset {
+				t.Errorf("Parse(%q) = %s %q UTC%+g, want %s %q UTC%+g",
+					tt.input, tz.Identifier(), tz.CountryCode(), tz.UtcOffset(), tt.want, tt.wantCode, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"", "Nowhere/Zone", "UTC+15", "UTC+5:75", "UTC+", "UTC*5", "GMT-3", "GMT+05:45"} {
+		if _, err := Parse(input); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Parse(%q) error = %v, want ErrNotFound", input, err)
+		}
+	}
+
+	_, err := Parse("Europe/Berln")
+	if err == nil || !strings.Contains(err.Error(), `did you mean "Europe/Berlin"?`) {
+		t.Errorf("Parse(\"Europe/Berln\") error = %v, want suggestion", err)
+	}
+}
+
+func TestParseLocal(t *testing.T) {
+	t.Parallel()
+
+	want, wantErr := Current()
+
+	got, err := Parse("Local")
+	if (err == nil) != (wantErr == nil) || got != want {
+		t.Errorf("Parse(\"Local\") = %v, %v, want %v, %v", got, err, want, wantErr)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Europe/Berln", "Europe/Berlin"},
+		{"berlin", "Europe/Berlin"},
+		{"Buenos Aires", "America/Argentina/Buenos_Aires"},
+		{"Americ/New_Yrok", "America/New_York"},
+		{"Calcuta", "Asia/Calcutta"},
+	}
+
+	for _, tt := range tests {
+		got := Suggest(tt.input)
+		if len(got) == 0 || got[0] != tt.want {
+			t.Errorf("Suggest(%q) = %v, want first %q", tt.input, got, tt.want)
+		}
+
+		if len(got) > maxSuggestions {
+			t.Errorf("Suggest(%q) returned %d suggestions, want at most %d", tt.input, len(got), maxSuggestions)
+		}
+	}
+
+	if got := Suggest("qqqqqqqqqqqq"); got != nil {
+		t.Errorf("Suggest(\"qqqqqqqqqqqq\") = %v, want nil", got)
+	}
+
+	// Only the closest matches are suggested.
+	if got := Suggest("Asia/Tokio"); !slices.Equal(got, []string{"Asia/Tokyo"}) {
+		t.Errorf("Suggest(\"Asia/Tokio\") = %v, want [Asia/Tokyo]", got)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"berlin", "berln", 1},
+	}
+
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestTimezoneFlag(t *testing.T) {
+	t.Parallel()
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	var zone Timezone
+
+	fs.Var(&zone, "tz", "timezone")
+
+	if err := fs.Parse([]string{"--tz", "Asia/Tokyo"}); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	if zone.String() != "Asia/Tokyo" {
+		t.Errorf("zone = %q, want %q", zone.String(), "Asia/Tokyo")
+	}
+
+	// The flag package flattens errors, so check the message.
+	if err := fs.Parse([]string{"--tz", "Asia/Tokio"}); err == nil || !strings.Contains(err.Error(), `did you mean "Asia/Tokyo"?`) {
+		t.Errorf("Parse() error = %v, want suggestion", err)
+	}
+
+	if zone.Type() != "timezone" {
+		t.Errorf("Type() = %q, want %q", zone.Type(), "timezone")
+	}
+}
+
+// Examples.
+
+func ExampleParse() {
+	for _, input := range []string{"europe/berlin", "UTC+05:30", "US/Pacific"} {
+		tz, err := Parse(input)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("%s UTC%+g\n", tz, tz.UtcOffset())
+	}
+
+	_, err := Parse("Europe/Berln")
+	fmt.Println(err)
+	// Output:
+	// Europe/Berlin UTC+1
+	// UTC+05:30 UTC+5.5
+	// America/Los_Angeles UTC-8
+	// timezone "Europe/Berln": timezone not found (did you mean "Europe/Berlin"?)
+}
@@ -0,0 +1,128 @@
+package tz
+
+import (
+	"slices"
+	"time"
+)
+
+// ZoneQuery is a composable filter over the timezones of a registry, created
+// with Query. Filters combine with AND; values given to the same filter, in
+// one call or several, combine with OR. A ZoneQuery is immutable: every method
+// returns a new query, so partial queries can be shared and extended.
+//
+//	zones := tz.Query().Region("America").OffsetBetween(-5, -3).ObservesDST(true).At(time.Now())
+type ZoneQuery struct {
+	reg       *Registry
+	countries []string
+	regions   []string
+	lo, hi    float32
+	hasOffset bool
+	dst       bool
+	hasDST    bool
+}
+
+// Query returns a query matching every timezone in the registry.
+func (r *Registry) Query() ZoneQuery {
+	return ZoneQuery{reg: r}
+}
+
+// Query returns a query matching every timezone in the default registry.
+// The registry is captured when Query is called.
+func Query() ZoneQuery {
+	return Default().Query()
+}
+
+// Country restricts the query to timezones of the given ISO 3166-1 alpha-2
+// country codes.
+func (q ZoneQuery) Country(codes ...string) ZoneQuery {
+	q.countries = slices.Concat(q.countries, codes)
+
+	return q
+}
+
+// Region restricts the query to timezones within the given regions, including
+// nested sub-regions, e.g. "America" or "America/Argentina".
+func (q ZoneQuery) Region(regions ...string) ZoneQuery {
+	q.regions = slices.Concat(q.regions, regions)
+
+	return q
+}
+
+// OffsetBetween restricts the query to timezones whose UTC offset in hours
+// lies within [lo, hi]. The offset is the standard offset for Run and the
+// offset in effect at the given instant for At. A later call replaces an
+// earlier one.
+func (q ZoneQuery) OffsetBetween(lo, hi float32) ZoneQuery {
+	q.lo, q.hi, q.hasOffset = lo, hi, true
+
+	return q
+}
+
+// ObservesDST restricts the query to timezones that do, or do not, observe
+// daylight saving time. A later call replaces an earlier one.
+func (q ZoneQuery) ObservesDST(observes bool) ZoneQuery {
+	q.dst, q.hasDST = observes, true
+
+	return q
+}
+
+// Run returns the matching timezones sorted by identifier, comparing standard
+// UTC offsets. Returns nil if no timezones match.
+func (q ZoneQuery) Run() []Timezone {
+	return q.run(func(tz Timezone) float32 { return tz.utcOffset })
+}
+
+// At returns the matching timezones sorted by identifier, comparing the UTC
+// offsets in effect at instant t. Returns nil if no timezones match.
+func (q ZoneQuery) At(t time.Time) []Timezone {
+	return q.run(func(tz Timezone) float32 { return tz.OffsetAt(t) })
+}
+
+func (q ZoneQuery) run(offset func(Timezone) float32) []Timezone {
+	var result []Timezone
+
+	for _, tz := range q.candidates() {
+		if q.matches(tz, offset) {
+			result = append(result, tz)
+		}
+	}
+
+	return result
+}
+
+// candidates returns the smallest sorted slice known to contain every match,
+// taken from the country index when countries are given. The result must not
+// be modified.
+func (q ZoneQuery) candidates() []Timezone {
+	if len(q.countries) != 1 {
+		return q.reg.sorted
+	}
+
+	q.reg.countryIndexOnce.Do(q.reg.buildCountryIndex)
+
+	return q.reg.countryIndex[q.countries[0]]
+}
+
+func (q ZoneQuery) matches(tz Timezone, offset func(Timezone) float32) bool {
+	if len(q.countries) > 0 && !slices.Contains(q.countries, tz.countryCode) {
+		return false
+	}
+
+	if len(q.regions) > 0 && !slices.ContainsFunc(q.regions, func(region string) bool {
+		return inRegion(tz.identifier, region)
+	}) {
+		return false
+	}
+
+	if q.hasDST && tz.ObservesDST() != q.dst {
+		return false
+	}
+
+	if q.hasOffset {
+		if o := offset(tz); o < q.lo || o > q.hi {
+			return false
+		}
+	}
+
+	return true
+}
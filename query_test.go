@@ -0,0 +1,105 @@
+package tz
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+
+	january := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	july := time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query ZoneQuery
+		at    time.Time
+		want  []string
+	}{
+		{
+			name:  "country",
+			query: Query().Country("NZ"),
+			want:  []string{"Pacific/Auckland", "Pacific/Chatham"},
+		},
+		{
+			name:  "countries",
+			query: Query().Country("NZ").Country("FJ"),
+			want:  []string{"Pacific/Auckland", "Pacific/Chatham", "Pacific/Fiji"},
+		},
+		{
+			name:  "country and DST",
+			query: Query().Country("AU").ObservesDST(false),
+			want:  []string{"Australia/Brisbane", "Australia/Darwin", "Australia/Eucla", "Australia/Lindeman", "Australia/Perth"},
+		},
+		{
+			name:  "region and standard offset",
+			query: Query().Region("America/Argentina").OffsetBetween(-3, -3).Country("AR").ObservesDST(false),
+			want: []string{
+				"America/Argentina/Buenos_Aires", "America/Argentina/Catamarca", "America/Argentina/Cordoba",
+				"America/Argentina/Jujuy", "America/Argentina/La_Rioja", "America/Argentina/Mendoza",
+				"America/Argentina/Rio_Gallegos", "America/Argentina/Salta", "America/Argentina/San_Juan",
+				"America/Argentina/San_Luis", "America/Argentina/Tucuman", "America/Argentina/Ushuaia",
+			},
+		},
+		{
+			name:  "offset in summer",
+			query: Query().Region("America").OffsetBetween(-5, -3).ObservesDST(true).Country("CL", "PY"),
+			at:    january,
+			want:  []string{"America/Santiago"},
+		},
+		{
+			name:  "offset in winter",
+			query: Query().Region("America").OffsetBetween(-5, -4).ObservesDST(true).Country("CL", "PY"),
+			at:    july,
+			want:  []string{"America/Santiago"},
+		},
+		{
+			name:  "regions",
+			query: Query().Region("Arctic", "Antarctica").OffsetBetween(-3, 0),
+			want:  []string{"Antarctica/Palmer", "Antarctica/Rothera", "Antarctica/Troll"},
+		},
+		{
+			name:  "no match",
+			query: Query().Country("DE").Region("Asia"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var zones []Timezone
+			if tt.at.IsZero() {
+				zones = tt.query.Run()
+			} else {
+				zones = tt.query.At(tt.at)
+			}
+
+			var got []string
+			for _, zone := range zones {
+				got = append(got, zone.Identifier())
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryImmutable(t *testing.T) {
+	t.Parallel()
+
+	base := Query().Country("US")
+	east := base.Country("CA")
+
+	if len(base.Run()) >= len(east.Run()) {
+		t.Error("extending a query modified the original")
+	}
+
+	if got := len(Query().Run()); got != len(All()) {
+		t.Errorf("empty query matched %d timezones, want %d", got, len(All()))
+	}
+}
@@ -0,0 +1,7 @@
+//go:build race
+
+package tz
+
+// raceEnabled reports whether the race detector is on; it makes escape
+// analysis more conservative, so allocation counts differ.
+const raceEnabled = true
@@ -0,0 +1,176 @@
+package tz
+
+import (
+	"slices"
+	"strings"
+)
+
+// Region is a node in the hierarchical view of timezone identifiers.
+// Top-level regions are IANA areas such as "Europe"; sub-regions are
+// nested areas such as "America/Argentina".
+type Region struct {
+	// Name is the last path segment of the region, e.g. "Argentina".
+	Name string
+	// Path is the full region path, e.g. "America/Argentina".
+	Path string
+	// Zones holds the timezones directly within the region, sorted by identifier.
+	Zones []Timezone
+	// SubRegions holds the nested regions, sorted by name.
+	SubRegions []Region
+}
+
+// Region returns the IANA area of the timezone, e.g. "America" for
+// "America/Argentina/Salta". Returns an empty string for identifiers
+// without an area, such as "UTC".
+func (t Timezone) Region() string {
+	area, _, ok := strings.Cut(t.identifier, "/")
+	if !ok {
+		return ""
+	}
+
+	return area
+}
+
+// SubRegion returns the full region path of the timezone, e.g.
+// "America/Argentina" for "America/Argentina/Salta" and "Europe" for
+// "Europe/Berlin". Returns an empty string for identifiers without an area.
+func (t Timezone) SubRegion() string {
+	i := strings.LastIndexByte(t.identifier, '/')
+	if i < 0 {
+		return ""
+	}
+
+	return t.identifier[:i]
+}
+
+func (r *Registry) buildRegionIndex() {
+	r.regionIndex = make(map[string][]Timezone)
+
+	for id, data := range r.zones {
+		tz := r.timezone(id, data)
+
+		// Index the zone under every enclosing region path.
+		for i := range len(id) {
+			if id[i] == '/' {
+				r.regionIndex[id[:i]] = append(r.regionIndex[id[:i]], tz)
+			}
+		}
+	}
+
+	sortIndex(r.regionIndex)
+}
+
+func (r *Registry) buildRegionTree() {
+	root := &Region{}
+
+	for _, tz := range r.sorted {
+		parts := strings.Split(tz.identifier, "/")
+		if len(parts) < 2 {
+			continue
+		}
+
+		node := root
+
+		for i, name := range parts[:len(parts)-1] {
+			node = node.child(name, strings.Join(parts[:i+1], "/"))
+		}
+
+		node.Zones = append(node.Zones, tz)
+	}
+
+	r.regionTree = root.SubRegions
+}
+
+// child returns the sub-region with the given name, appending it if missing.
+// Identifiers are visited in sorted order, so sub-regions stay sorted by name.
+func (r *Region) child(name, path string) *Region {
+	for i := range r.SubRegions {
+		if r.SubRegions[i].Name == name {
+			return &r.SubRegions[i]
+		}
+	}
+
+	r.SubRegions = append(r.SubRegions, Region{Name: name, Path: path})
+
+	return &r.SubRegions[len(r.SubRegions)-1]
+}
+
+// Regions returns a sorted slice of all top-level areas, e.g. "Africa",
+// "America" and "Europe".
+func (r *Registry) Regions() []string {
+	r.regionTreeOnce.Do(r.buildRegionTree)
+
+	result := make([]string, 0, len(r.regionTree))
+
+	for _, region := range r.regionTree {
+		result = append(result, region.Name)
+	}
+
+	return result
+}
+
+// ByRegion returns all timezones within the given region, including those in
+// nested sub-regions. The region may be a top-level area such as "America"
+// or a sub-area such as "America/Argentina".
+// Results are sorted by identifier. Returns nil if no timezones match.
+func (r *Registry) ByRegion(region string) []Timezone {
+	r.regionIndexOnce.Do(r.buildRegionIndex)
+
+	return slices.Clone(r.regionIndex[region])
+}
+
+// Tree returns the hierarchical view of all timezones grouped by region and
+// sub-region, sorted by name. Identifiers without an area, such as "UTC",
+// are not included.
+func (r *Registry) Tree() []Region {
+	r.regionTreeOnce.Do(r.buildRegionTree)
+
+	return cloneRegions(r.regionTree)
+}
+
+// inRegion reports whether the identifier lies within region or one of its
+// sub-regions.
+func inRegion(identifier, region string) bool {
+	return len(identifier) > len(region) && identifier[len(region)] == '/' && strings.HasPrefix(identifier, region)
+}
+
+// cloneRegions deep-copies a region tree so callers cannot modify the cache.
+func cloneRegions(regions []Region) []Region {
+	if regions == nil {
+		return nil
+	}
+
+	result := make([]Region, len(regions))
+
+	for i, region := range regions {
+		result[i] = Region{
+			Name:       region.Name,
+			Path:       region.Path,
+			Zones:      slices.Clone(region.Zones),
+			SubRegions: cloneRegions(region.SubRegions),
+		}
+	}
+
+	return result
+}
+
+// Regions returns a sorted slice of all top-level IANA areas, e.g. "Africa",
+// "America" and "Europe".
+func Regions() []string {
+	return Default().Regions()
+}
+
+// ByRegion returns all timezones within the given region, including those in
+// nested sub-regions. The region may be a top-level area such as "America"
+// or a sub-area such as "America/Argentina".
+// Results are sorted by identifier. Returns nil if no timezones match.
+func ByRegion(region string) []Timezone {
+	return Default().ByRegion(region)
+}
+
+// Tree returns the hierarchical view of all timezones grouped by region and
+// sub-region, sorted by name. Identifiers without an area, such as "UTC",
+// are not included.
+func Tree() []Region {
+	return Default().Tree()
+}
@@ -0,0 +1,153 @@
+package tz
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTimezoneRegion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		identifier    string
+		wantRegion    string
+		wantSubRegion string
+	}{
+		{"Europe/Berlin", "Europe", "Europe"},
+		{"America/Argentina/Salta", "America", "America/Argentina"},
+		{"America/Indiana/Indianapolis", "America", "America/Indiana"},
+		{"Etc/UTC", "Etc", "Etc"},
+		{"UTC", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.identifier, func(t *testing.T) {
+			t.Parallel()
+
+			tz, err := Decode(tt.identifier)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := tz.Region(); got != tt.wantRegion {
+				t.Errorf("Region() = %q, want %q", got, tt.wantRegion)
+			}
+
+			if got := tz.SubRegion(); got != tt.wantSubRegion {
+				t.Errorf("SubRegion() = %q, want %q", got, tt.wantSubRegion)
+			}
+		})
+	}
+}
+
+func TestRegions(t *testing.T) {
+	t.Parallel()
+
+	want := []string{"Africa", "America", "Antarctica", "Asia", "Atlantic", "Australia", "Etc", "Europe", "Indian", "Pacific"}
+
+	if got := Regions(); !slices.Equal(got, want) {
+		t.Errorf("Regions() = %v, want %v", got, want)
+	}
+}
+
+func TestByRegion(t *testing.T) {
+	t.Parallel()
+
+	europe := ByRegion("Europe")
+	if len(europe) == 0 {
+		t.Fatal("ByRegion(\"Europe\") returned empty slice")
+	}
+
+	for i, tz := range europe {
+		if !strings.HasPrefix(tz.Identifier(), "Europe/") {
+			t.Errorf("ByRegion(\"Europe\") contains %q", tz.Identifier())
+		}
+
+		if i > 0 && tz.Identifier() <= europe[i-1].Identifier() {
+			t.Errorf("ByRegion(\"Europe\") not sorted: %q >= %q", europe[i-1].Identifier(), tz.Identifier())
+		}
+	}
+
+	// Top-level areas include zones in nested sub-areas.
+	if !slices.ContainsFunc(ByRegion("America"), func(tz Timezone) bool { return tz.Identifier() == "America/Argentina/Salta" }) {
+		t.Error("ByRegion(\"America\") does not contain America/Argentina/Salta")
+	}
+
+	// Sub-areas are addressable directly.
+	for _, tz := range ByRegion("America/Argentina") {
+		if tz.SubRegion() != "America/Argentina" {
+			t.Errorf("ByRegion(\"America/Argentina\") contains %q", tz.Identifier())
+		}
+	}
+
+	// Partial segments and unknown regions do not match.
+	for _, region := range []string{"Eur", "America/Arg", "Nowhere", ""} {
+		if got := ByRegion(region); got != nil {
+			t.Errorf("ByRegion(%q) = %v, want nil", region, got)
+		}
+	}
+}
+
+func TestTree(t *testing.T) {
+	t.Parallel()
+
+	tree := Tree()
+
+	// Every identifier with an area appears exactly once in the tree.
+	var count int
+
+	var walk func(regions []Region)
+
+	walk = func(regions []Region) {
+		for i, r := range regions {
+			if i > 0 && r.Name <= regions[i-1].Name {
+				t.Errorf("regions not sorted: %q >= %q", regions[i-1].Name, r.Name)
+			}
+
+			for _, tz := range r.Zones {
+				if tz.SubRegion() != r.Path {
+					t.Errorf("%s: listed under %q", tz.Identifier(), r.Path)
+				}
+			}
+
+			count += len(r.Zones)
+
+			walk(r.SubRegions)
+		}
+	}
+
+	walk(tree)
+
+	var want int
+
+	for id := range timezones {
+		if strings.Contains(id, "/") {
+			want++
+		}
+	}
+
+	if count != want {
+		t.Errorf("Tree() contains %d zones, want %d", count, want)
+	}
+}
+
+// Examples.
+
+func ExampleTree() {
+	for _, region := range Tree() {
+		if region.Name != "America" {
+			continue
+		}
+
+		for _, sub := range region.SubRegions {
+			fmt.Printf("%s (%d)\n", sub.Path, len(sub.Zones))
+		}
+	}
+	// Output:
+	// America/Argentina (12)
+	// America/Indiana (8)
+	// America/Kentucky (2)
+	// America/North_Dakota (3)
+}
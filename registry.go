@@ -0,0 +1,617 @@
+package tz
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidZone is returned when zone data supplied to a Registry is malformed.
+var ErrInvalidZone = errors.New("invalid timezone data")
+
+// ZoneData describes a single timezone entry supplied to NewRegistry or Registry.With.
+type ZoneData struct {
+	// Identifier is the timezone identifier, e.g. "Europe/Berlin" or "Company/HQ".
+	Identifier string
+	// CountryCode is the ISO 3166-1 alpha-2 country code, or empty for none.
+	CountryCode string
+	// UtcOffset is the standard UTC offset in hours: the lowest offset in
+	// effect during the year, so daylight saving time always moves clocks
+	// forward from it.
+	UtcOffset float32
+	// Rule is the POSIX TZ rule describing daylight saving time, e.g.
+	// "CET-1CEST,M3.5.0,M10.5.0/3", or empty if the zone does not observe it.
+	// The lower of the rule's two offsets must match UtcOffset; for rules
+	// with negative DST, such as Europe/Dublin's "IST-1GMT0,M10.5.0,M3.5.0/1",
+	// that is the DST offset.
+	Rule string
+	// History lists the zone's past UTC offset changes, oldest first, as
+	// read from compiled zoneinfo. It is optional; the embedded dataset
+	// records only current rules.
+	History []Transition
+}
+
+// LinkData describes an alias identifier supplied to Registry.WithLinks.
+type LinkData struct {
+	// Name is the alias identifier, e.g. "US/Eastern".
+	Name string
+	// Target is the canonical identifier the alias resolves to, e.g. "America/New_York".
+	Target string
+}
+
+// Registry is an immutable set of timezones with lookup indices.
+// A Registry is safe for concurrent use. The package-level functions
+// are backed by the registry returned by Default.
+type Registry struct {
+	zones   map[string]tzData
+	rules   map[string]string
+	links   map[string]string
+	history map[string][]Transition
+	version string
+
+	digest     string
+	digestOnce sync.Once
+
+	countryIndex     map[string][]Timezone
+	countryIndexOnce sync.Once
+
+	offsetIndex     map[float32][]Timezone
+	offsetIndexOnce sync.Once
+
+	regionIndex     map[string][]Timezone
+	regionIndexOnce sync.Once
+
+	regionTree     []Region
+	regionTreeOnce sync.Once
+
+	// sorted and countryCodes are built eagerly so that iterators stay
+	// small enough to be inlined; see Zones.
+	sorted       []Timezone
+	countryCodes []string
+}
+
+// embedded is the registry backed by the compiled-in dataset.
+var embedded = (&Registry{zones: timezones, rules: rules, links: links}).sort()
+
+// Embedded returns the registry backed by the compiled-in dataset.
+func Embedded() *Registry {
+	return embedded
+}
+
+// NewRegistry returns a registry containing only the given zones and no links.
+// Later entries override earlier entries with the same identifier.
+// Returns ErrInvalidZone (wrapped) if any entry is malformed.
+func NewRegistry(zones []ZoneData) (*Registry, error) {
+	return (&Registry{}).With(zones...)
+}
+
+// With returns a new registry that overlays the given zones on top of r,
+// adding new identifiers and overriding existing ones. A zone replaces any
+// link of the same name. r is not modified.
+// Returns ErrInvalidZone (wrapped) if any entry is malformed.
+func (r *Registry) With(zones ...ZoneData) (*Registry, error) {
+	next := r.clone()
+
+	for _, z := range zones {
+		if err := z.validate(); err != nil {
+			return nil, err
+		}
+
+		next.zones[z.Identifier] = tzData{countryCode: z.CountryCode, utcOffset: z.UtcOffset}
+		delete(next.links, z.Identifier)
+
+		if z.Rule != "" {
+			next.rules[z.Identifier] = z.Rule
+		} else {
+			delete(next.rules, z.Identifier)
+		}
+
+		if len(z.History) > 0 {
+			next.history[z.Identifier] = slices.Clone(z.History)
+		} else {
+			delete(next.history, z.Identifier)
+		}
+	}
+
+	return next.sort(), nil
+}
+
+// WithLinks returns a new registry that adds the given alias links to r,
+// overriding existing links of the same name. Links must target a zone in the
+// registry and must not shadow a zone. r is not modified.
+// Returns ErrInvalidZone (wrapped) if any link is malformed.
+func (r *Registry) WithLinks(links ...LinkData) (*Registry, error) {
+	next := r.clone()
+
+	for _, l := range links {
+		if l.Name == "" || strings.TrimSpace(l.Name) != l.Name {
+			return nil, fmt.Errorf("link %q: malformed name: %w", l.Name, ErrInvalidZone)
+		}
+
+		if _, ok := next.zones[l.Name]; ok {
+			return nil, fmt.Errorf("link %q: shadows a zone: %w", l.Name, ErrInvalidZone)
+		}
+
+		if _, ok := next.zones[l.Target]; !ok {
+			return nil, fmt.Errorf("link %q: unknown target %q: %w", l.Name, l.Target, ErrInvalidZone)
+		}
+
+		next.links[l.Name] = l.Target
+	}
+
+	return next, nil
+}
+
+// clone returns an unversioned copy of r's data without indices.
+func (r *Registry) clone() *Registry {
+	next := &Registry{
+		zones: make(map[string]tzData, len(r.zones)),
+		rules: make(map[string]string, len(r.rules)),
+		links: make(map[string]string, len(r.links)),
+
+		history: make(map[string][]Transition, len(r.history)),
+
+		// Shared until With changes the zones; neither is ever modified.
+		sorted:       r.sorted,
+		countryCodes: r.countryCodes,
+	}
+
+	maps.Copy(next.zones, r.zones)
+	maps.Copy(next.rules, r.rules)
+	maps.Copy(next.links, r.links)
+	maps.Copy(next.history, r.history) // History slices are never modified.
+
+	return next
+}
+
+// UTC offsets in hours accepted for zones and fixed zones.
+const (
+	minUtcOffset = -12
+	maxUtcOffset = 14
+)
+
+func (z ZoneData) validate() error {
+	if z.Identifier == "" || strings.TrimSpace(z.Identifier) != z.Identifier {
+		return fmt.Errorf("zone %q: malformed identifier: %w", z.Identifier, ErrInvalidZone)
+	}
+
+	if cc := z.CountryCode; cc != "" && (len(cc) != 2 || cc[0] < 'A' || cc[0] > 'Z' || cc[1] < 'A' || cc[1] > 'Z') {
+		return fmt.Errorf("zone %q: malformed country code %q: %w", z.Identifier, cc, ErrInvalidZone)
+	}
+
+	if z.UtcOffset < minUtcOffset || z.UtcOffset > maxUtcOffset {
+		return fmt.Errorf("zone %q: UTC offset %v out of range [-12, 14]: %w", z.Identifier, z.UtcOffset, ErrInvalidZone)
+	}
+
+	for i, t := range z.History {
+		if t.Offset <= -24 || t.Offset >= 24 || i > 0 && !t.At.After(z.History[i-1].At) {
+			return fmt.Errorf("zone %q: history entry %d out of order or range: %w", z.Identifier, i, ErrInvalidZone)
+		}
+	}
+
+	if z.Rule == "" {
+		return nil
+	}
+
+	rule, err := parseRule(z.Rule)
+	if err != nil {
+		return fmt.Errorf("zone %q: %w", z.Identifier, err)
+	}
+
+	if float32(min(rule.stdOffset, rule.dstOffset))/3600 != z.UtcOffset {
+		return fmt.Errorf("zone %q: rule lowest offset does not match UTC offset %v: %w", z.Identifier, z.UtcOffset, ErrInvalidZone)
+	}
+
+	return nil
+}
+
+// LoadDir returns a registry built from a compiled zoneinfo directory such as
+// /usr/share/zoneinfo. See LoadFS for details.
+func LoadDir(dir string) (*Registry, error) {
+	return LoadFS(os.DirFS(dir))
+}
+
+// LoadFS returns a registry built from a compiled zoneinfo tree. Identifiers and
+// country codes are read from zone.tab; standard offsets, the lowest offsets
+// in effect, are derived from each zone's TZif file for the current year,
+// daylight saving time rules from the TZif footer, and the history from the
+// TZif transitions before the current year. Zones whose footer has no rule
+// but which change offset twice during the year, such as Africa/Casablanca
+// during Ramadan, get a rule with the current year's dates. Links are not
+// read. Etc/UTC and UTC are always included.
+func LoadFS(fsys fs.FS) (*Registry, error) {
+	tab, err := fs.ReadFile(fsys, "zone.tab")
+	if err != nil {
+		return nil, fmt.Errorf("read zone.tab: %w", err)
+	}
+
+	zones := []ZoneData{
+		{Identifier: "Etc/UTC"},
+		{Identifier: "UTC"},
+	}
+
+	year := time.Now().Year()
+	scanner := bufio.NewScanner(bytes.NewReader(tab))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		// Columns: country code, coordinates, identifier, optional comments.
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("zone.tab: malformed line %q: %w", line, ErrInvalidZone)
+		}
+
+		data, err := fs.ReadFile(fsys, fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("read zone %q: %w", fields[2], err)
+		}
+
+		loc, err := time.LoadLocationFromTZData(fields[2], data)
+		if err != nil {
+			return nil, fmt.Errorf("zone %q: %w: %w", fields[2], ErrInvalidZone, err)
+		}
+
+		zones = append(zones, ZoneData{
+			Identifier:  fields[2],
+			CountryCode: fields[0],
+			UtcOffset:   lowestOffset(loc, year),
+			Rule:        cmp.Or(footerRule(data), yearRule(loc, year)),
+			History:     history(loc, year),
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read zone.tab: %w", err)
+	}
+
+	return NewRegistry(zones)
+}
+
+// lowestOffset returns the lowest UTC offset in hours of loc in effect
+// during the given year.
+func lowestOffset(loc *time.Location, year int) float32 {
+	t := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	end := t.AddDate(1, 0, 0)
+
+	_, lowest := t.Zone()
+
+	for {
+		_, next := t.ZoneBounds()
+		if next.IsZero() || !next.Before(end) {
+			break
+		}
+
+		t = next
+
+		_, offset := t.Zone()
+		lowest = min(lowest, offset)
+	}
+
+	return float32(lowest) / 3600
+}
+
+// history returns the offset changes of loc before the given year.
+func history(loc *time.Location, year int) []Transition {
+	var result []Transition
+
+	end := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	t := time.Time{}.In(loc)
+
+	for {
+		_, next := t.ZoneBounds()
+		if next.IsZero() || !next.Before(end) {
+			return result
+		}
+
+		t = next
+		name, offset := t.Zone()
+
+		result = append(result, Transition{At: t, Offset: float32(offset) / 3600, Abbreviation: name, DST: t.IsDST()})
+	}
+}
+
+// footerRule returns the POSIX TZ rule from the footer of TZif version 2+
+// data if it describes daylight saving time, or an empty string otherwise.
+func footerRule(data []byte) string {
+	data = bytes.TrimRight(data, "\n")
+
+	i := bytes.LastIndexByte(data, '\n')
+	if i < 0 || !bytes.HasPrefix(data, []byte("TZif")) {
+		return ""
+	}
+
+	footer := string(data[i+1:])
+	if _, err := parseRule(footer); err != nil {
+		return ""
+	}
+
+	return footer
+}
+
+// yearRule returns a POSIX TZ rule with zero-based day numbers describing the
+// two offset changes of loc during the given year, or an empty string if it
+// does not change offset exactly twice. DST is the higher of the two offsets.
+func yearRule(loc *time.Location, year int) string {
+	t := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	end := t.AddDate(1, 0, 0)
+
+	var changes []time.Time
+
+	for {
+		_, next := t.ZoneBounds()
+		if next.IsZero() || !next.Before(end) {
+			break
+		}
+
+		t = next
+		changes = append(changes, t)
+	}
+
+	if len(changes) != 2 {
+		return ""
+	}
+
+	_, first := changes[0].Zone()
+	_, second := changes[1].Zone()
+
+	if first == second {
+		return ""
+	}
+
+	// Order the changes as the start of DST, then its end.
+	if first < second {
+		changes[0], changes[1] = changes[1], changes[0]
+	}
+
+	dstName, dstOffset := changes[0].Zone()
+	stdName, stdOffset := changes[1].Zone()
+
+	rule := posixName(stdName) + posixOffset(-stdOffset) + posixName(dstName)
+	if dstOffset != stdOffset+3600 {
+		rule += posixOffset(-dstOffset)
+	}
+
+	// Transition times are local wall-clock times before each change.
+	for i, offset := range []int{stdOffset, dstOffset} {
+		local := changes[i].Add(time.Duration(offset) * time.Second).UTC()
+		seconds := local.Hour()*3600 + local.Minute()*60 + local.Second()
+		rule += fmt.Sprintf(",%d/%s", local.YearDay()-1, posixOffset(seconds))
+	}
+
+	return rule
+}
+
+// posixName quotes a time zone abbreviation for a POSIX TZ rule if it
+// contains characters other than letters, e.g. "<+01>".
+func posixName(name string) string {
+	for _, c := range name {
+		if (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
+			return "<" + name + ">"
+		}
+	}
+
+	return name
+}
+
+// posixOffset formats seconds as a POSIX TZ offset or time, e.g. "-1" or
+// "2:30".
+func posixOffset(seconds int) string {
+	sign := ""
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+
+	switch {
+	case seconds%60 != 0:
+		return fmt.Sprintf("%s%d:%02d:%02d", sign, seconds/3600, seconds%3600/60, seconds%60)
+	case seconds%3600 != 0:
+		return fmt.Sprintf("%s%d:%02d", sign, seconds/3600, seconds%3600/60)
+	default:
+		return fmt.Sprintf("%s%d", sign, seconds/3600)
+	}
+}
+
+// Version returns the dataset version of the registry. Registries read from a
+// dataset file report the version recorded in the file; all others report a
+// digest of their contents, so equal datasets share a version.
+func (r *Registry) Version() string {
+	if r.version != "" {
+		return r.version
+	}
+
+	r.digestOnce.Do(r.buildDigest)
+
+	return r.digest
+}
+
+func (r *Registry) buildDigest() {
+	var b strings.Builder
+
+	for _, id := range r.All() {
+		data := r.zones[id]
+		fmt.Fprintf(&b, "%s\x00%s\x00%v\x00%s\n", id, data.countryCode, data.utcOffset, r.rules[id])
+
+		for _, t := range r.history[id] {
+			fmt.Fprintf(&b, "\x00%d\x00%v\x00%s\x00%t\n", t.At.Unix(), t.Offset, t.Abbreviation, t.DST)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(r.links)) {
+		fmt.Fprintf(&b, "%s\x00%s\n", name, r.links[name])
+	}
+
+	sum := sha256.Sum256([]byte(b.String()))
+
+	r.digest = hex.EncodeToString(sum[:8])
+}
+
+// warm builds all lazy indices so that a registry can be published fully built.
+func (r *Registry) warm() {
+	r.Version()
+	r.countryIndexOnce.Do(r.buildCountryIndex)
+	r.offsetIndexOnce.Do(r.buildOffsetIndex)
+	r.regionIndexOnce.Do(r.buildRegionIndex)
+	r.regionTreeOnce.Do(r.buildRegionTree)
+}
+
+// Len returns the number of timezones in the registry.
+func (r *Registry) Len() int {
+	return len(r.zones)
+}
+
+// Decode looks up a timezone by its identifier. Alias identifiers such as
+// "US/Eastern" resolve to their canonical timezone, and the identifiers of
+// fixed zones such as "UTC+05:45" to the zone returned by FixedZone.
+// Returns ErrNotFound (wrapped) if the identifier is not recognized.
+func (r *Registry) Decode(identifier string) (Timezone, error) {
+	id, ok := r.Canonical(identifier)
+	if !ok {
+		if tz, ok := decodeFixed(identifier); ok {
+			return tz, nil
+		}
+
+		return Timezone{}, fmt.Errorf("timezone %q: %w", identifier, ErrNotFound)
+	}
+
+	return r.timezone(id, r.zones[id]), nil
+}
+
+// Canonical returns the canonical identifier for a timezone or alias
+// identifier. Reports false if the identifier is not recognized.
+func (r *Registry) Canonical(identifier string) (string, bool) {
+	if _, ok := r.zones[identifier]; ok {
+		return identifier, true
+	}
+
+	target, ok := r.links[identifier]
+
+	return target, ok
+}
+
+// IsValid reports whether the given identifier is a recognized timezone or
+// alias, or the identifier of a fixed zone.
+func (r *Registry) IsValid(identifier string) bool {
+	if _, ok := r.Canonical(identifier); ok {
+		return true
+	}
+
+	_, ok := decodeFixed(identifier)
+
+	return ok
+}
+
+// History returns the past UTC offset changes of a timezone, oldest first,
+// resolving aliases. Returns nil if the registry records no history for it;
+// registries loaded from compiled zoneinfo or from a binary dataset that
+// includes history do.
+func (r *Registry) History(identifier string) []Transition {
+	id, _ := r.Canonical(identifier)
+
+	return slices.Clone(r.history[id])
+}
+
+// Links returns a copy of the registry's alias links, mapping each alias to
+// its canonical identifier.
+func (r *Registry) Links() map[string]string {
+	return maps.Clone(r.links)
+}
+
+// All returns a sorted slice of all canonical timezone identifiers in the registry.
+func (r *Registry) All() []string {
+	result := make([]string, len(r.sorted))
+
+	for i, tz := range r.sorted {
+		result[i] = tz.identifier
+	}
+
+	return result
+}
+
+// sort caches all timezones sorted by identifier and the sorted set of
+// country codes they reference. It must be called once the registry's zones
+// are final and returns r for chaining.
+func (r *Registry) sort() *Registry {
+	r.sorted = make([]Timezone, 0, len(r.zones))
+	codes := make(map[string]bool)
+
+	for id, data := range r.zones {
+		r.sorted = append(r.sorted, r.timezone(id, data))
+
+		if data.countryCode != "" {
+			codes[data.countryCode] = true
+		}
+	}
+
+	sort.Slice(r.sorted, func(i, j int) bool {
+		return r.sorted[i].identifier < r.sorted[j].identifier
+	})
+
+	r.countryCodes = slices.Sorted(maps.Keys(codes))
+
+	return r
+}
+
+func (r *Registry) buildCountryIndex() {
+	r.countryIndex = make(map[string][]Timezone, len(r.zones))
+
+	for id, data := range r.zones {
+		r.countryIndex[data.countryCode] = append(r.countryIndex[data.countryCode], r.timezone(id, data))
+	}
+
+	sortIndex(r.countryIndex)
+}
+
+func (r *Registry) buildOffsetIndex() {
+	r.offsetIndex = make(map[float32][]Timezone, len(r.zones))
+
+	for id, data := range r.zones {
+		r.offsetIndex[data.utcOffset] = append(r.offsetIndex[data.utcOffset], r.timezone(id, data))
+	}
+
+	sortIndex(r.offsetIndex)
+}
+
+// sortIndex sorts each slice of the index by identifier for deterministic output.
+func sortIndex[K comparable](index map[K][]Timezone) {
+	for key := range index {
+		slice := index[key]
+
+		sort.Slice(slice, func(i, j int) bool {
+			return slice[i].identifier < slice[j].identifier
+		})
+	}
+}
+
+// ByCountryCode returns all timezones for the given ISO 3166-1 alpha-2 country code.
+// Results are sorted by identifier. Returns nil if no timezones match.
+func (r *Registry) ByCountryCode(code string) []Timezone {
+	r.countryIndexOnce.Do(r.buildCountryIndex)
+
+	return slices.Clone(r.countryIndex[code])
+}
+
+// ByUtcOffset returns all timezones with the given standard UTC offset in hours.
+// Results are sorted by identifier. Returns nil if no timezones match.
+func (r *Registry) ByUtcOffset(offset float32) []Timezone {
+	r.offsetIndexOnce.Do(r.buildOffsetIndex)
+
+	return slices.Clone(r.offsetIndex[offset])
+}
@@ -0,0 +1,349 @@
+package tz
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestNewRegistry(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewRegistry([]ZoneData{
+		{Identifier: "Company/HQ", CountryCode: "NO", UtcOffset: 1},
+		{Identifier: "Company/Lab", CountryCode: "IN", UtcOffset: 5.5},
+		{Identifier: "Company/HQ", CountryCode: "NO", UtcOffset: 2},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if r.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", r.Len())
+	}
+
+	// Later entries override earlier ones.
+	hq, err := r.Decode("Company/HQ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if hq.UtcOffset() != 2 || hq.CountryCode() != "NO" {
+		t.Errorf("Decode(\"Company/HQ\") = %v, want NO UTC+2", hq)
+	}
+
+	// Zones from the embedded dataset are not included.
+	if _, err := r.Decode("Europe/Berlin"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Decode(\"Europe/Berlin\") error = %v, want ErrNotFound", err)
+	}
+
+	if got := r.ByUtcOffset(5.5); len(got) != 1 || got[0].Identifier() != "Company/Lab" {
+		t.Errorf("ByUtcOffset(5.5) = %v, want [Company/Lab]", got)
+	}
+
+	if got := r.ByRegion("Company"); len(got) != 2 {
+		t.Errorf("ByRegion(\"Company\") returned %d zones, want 2", len(got))
+	}
+}
+
+func TestNewRegistryInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		zone ZoneData
+	}{
+		{name: "empty identifier", zone: ZoneData{}},
+		{name: "padded identifier", zone: ZoneData{Identifier: " Company/HQ"}},
+		{name: "lowercase country", zone: ZoneData{Identifier: "Company/HQ", CountryCode: "no"}},
+		{name: "long country", zone: ZoneData{Identifier: "Company/HQ", CountryCode: "NOR"}},
+		{name: "offset too low", zone: ZoneData{Identifier: "Company/HQ", UtcOffset: -13}},
+		{name: "offset too high", zone: ZoneData{Identifier: "Company/HQ", UtcOffset: 15}},
+		{name: "malformed rule", zone: ZoneData{Identifier: "Company/HQ", UtcOffset: 1, Rule: "CET-1"}},
+		{name: "rule offset mismatch", zone: ZoneData{Identifier: "Company/HQ", UtcOffset: 2, Rule: "CET-1CEST,M3.5.0,M10.5.0/3"}},
+		{name: "unordered history", zone: ZoneData{Identifier: "Company/HQ", History: []Transition{{At: time.Unix(100, 0)}, {At: time.Unix(100, 0)}}}},
+		{name: "history offset out of range", zone: ZoneData{Identifier: "Company/HQ", History: []Transition{{At: time.Unix(100, 0), Offset: 24}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := NewRegistry([]ZoneData{tt.zone}); !errors.Is(err, ErrInvalidZone) {
+				t.Errorf("NewRegistry() error = %v, want ErrInvalidZone", err)
+			}
+		})
+	}
+}
+
+func TestRegistryWith(t *testing.T) {
+	t.Parallel()
+
+	r, err := Embedded().With(
+		ZoneData{Identifier: "Company/HQ", CountryCode: "DE", UtcOffset: 1},
+		ZoneData{Identifier: "Europe/Berlin", CountryCode: "DE", UtcOffset: 2},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if r.Len() != len(timezones)+1 {
+		t.Errorf("Len() = %d, want %d", r.Len(), len(timezones)+1)
+	}
+
+	// Overridden zone.
+	berlin, err := r.Decode("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if berlin.UtcOffset() != 2 {
+		t.Errorf("overlay Europe/Berlin UtcOffset() = %v, want 2", berlin.UtcOffset())
+	}
+
+	// Added zone is indexed alongside embedded zones.
+	found := false
+
+	for _, tz := range r.ByCountryCode("DE") {
+		if tz.Identifier() == "Company/HQ" {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Error("overlay ByCountryCode(\"DE\") does not contain Company/HQ")
+	}
+
+	// The base registry is unchanged.
+	if IsValid("Company/HQ") {
+		t.Error("overlay leaked into the default registry")
+	}
+
+	if base, _ := Decode("Europe/Berlin"); base.UtcOffset() != 1 {
+		t.Errorf("default Europe/Berlin UtcOffset() = %v, want 1", base.UtcOffset())
+	}
+
+	if _, err := r.With(ZoneData{}); !errors.Is(err, ErrInvalidZone) {
+		t.Errorf("With(ZoneData{}) error = %v, want ErrInvalidZone", err)
+	}
+}
+
+func TestRegistryLinks(t *testing.T) {
+	t.Parallel()
+
+	// Aliases resolve to their canonical timezone.
+	tz, err := Decode("US/Eastern")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tz.Identifier() != "America/New_York" {
+		t.Errorf("Decode(\"US/Eastern\") Identifier() = %q, want %q", tz.Identifier(), "America/New_York")
+	}
+
+	if !IsValid("Asia/Calcutta") {
+		t.Error("IsValid(\"Asia/Calcutta\") = false, want true")
+	}
+
+	// All lists canonical identifiers only.
+	for _, id := range All() {
+		if _, ok := links[id]; ok {
+			t.Errorf("All() contains alias %q", id)
+		}
+	}
+
+	r, err := Embedded().WithLinks(LinkData{Name: "Company/HQ", Target: "Europe/Oslo"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if id, ok := r.Canonical("Company/HQ"); !ok || id != "Europe/Oslo" {
+		t.Errorf("Canonical(\"Company/HQ\") = %q, %v, want Europe/Oslo", id, ok)
+	}
+
+	// A zone replaces a link of the same name.
+	r, err = r.With(ZoneData{Identifier: "Company/HQ", CountryCode: "NO", UtcOffset: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := r.Links()["Company/HQ"]; ok {
+		t.Error("With() did not replace the link Company/HQ")
+	}
+
+	for _, link := range []LinkData{
+		{Name: "", Target: "Europe/Oslo"},
+		{Name: "Company/HQ", Target: "Europe/Oslo"},
+		{Name: "Company/Lab", Target: "Nowhere/Zone"},
+		{Name: "Company/Lab", Target: "US/Eastern"},
+	} {
+		if _, err := r.WithLinks(link); !errors.Is(err, ErrInvalidZone) {
+			t.Errorf("WithLinks(%+v) error = %v, want ErrInvalidZone", link, err)
+		}
+	}
+}
+
+func TestLinksIntegrity(t *testing.T) {
+	t.Parallel()
+
+	for name, target := range links {
+		if _, ok := timezones[name]; ok {
+			t.Errorf("%s: link shadows a timezone", name)
+		}
+
+		if _, ok := timezones[target]; !ok {
+			t.Errorf("%s: link target %q is not a timezone", name, target)
+		}
+	}
+}
+
+func TestLinksKeepCountry(t *testing.T) {
+	t.Parallel()
+
+	// Aliases of zones that tzdata merged across countries resolve to the
+	// zone of their own country.
+	tests := map[string]string{
+		"Africa/Asmera":         "ER",
+		"Africa/Timbuktu":       "ML",
+		"America/Coral_Harbour": "CA",
+		"America/Virgin":        "VI",
+		"Antarctica/South_Pole": "AQ",
+		"Iceland":               "IS",
+		"Pacific/Ponape":        "FM",
+		"Pacific/Truk":          "FM",
+		"Pacific/Yap":           "FM",
+		// Svalbard and Jan Mayen have no zone of their own and follow Norway.
+		"Arctic/Longyearbyen": "NO",
+		"Atlantic/Jan_Mayen":  "NO",
+	}
+
+	for alias, want := range tests {
+		tz, err := Decode(alias)
+		if err != nil {
+			t.Errorf("Decode(%q): unexpected error: %v", alias, err)
+
+			continue
+		}
+
+		if got := tz.CountryCode(); got != want {
+			t.Errorf("Decode(%q) = %s with country %q, want %q", alias, tz.Identifier(), got, want)
+		}
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	t.Parallel()
+
+	const dir = "/usr/share/zoneinfo"
+
+	if _, err := os.Stat(dir + "/zone.tab"); err != nil {
+		t.Skipf("system zoneinfo not available: %v", err)
+	}
+
+	r, err := LoadDir(dir)
+	if err != nil {
+		t.Fatalf("LoadDir() error: %v", err)
+	}
+
+	tokyo, err := r.Decode("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tokyo.CountryCode() != "JP" || tokyo.UtcOffset() != 9 {
+		t.Errorf("Asia/Tokyo = %s UTC%+g, want JP UTC+9", tokyo.CountryCode(), tokyo.UtcOffset())
+	}
+
+	// Standard offsets are reported even for zones observing DST.
+	nyc, err := r.Decode("America/New_York")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if nyc.UtcOffset() != -5 {
+		t.Errorf("America/New_York UtcOffset() = %v, want -5", nyc.UtcOffset())
+	}
+
+	if got := r.rules["America/New_York"]; got != rules["America/New_York"] {
+		t.Errorf("America/New_York rule = %q, want %q", got, rules["America/New_York"])
+	}
+
+	if !r.IsValid("UTC") {
+		t.Error("LoadDir() registry does not contain UTC")
+	}
+
+	// LoadDir and CheckDir agree on standard offsets, including negative DST.
+	if drift, err := r.CheckDir(dir); err != nil || len(drift) != 0 {
+		t.Errorf("CheckDir() = %v, %v, want no drift", drift, err)
+	}
+}
+
+func TestYearRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{"Africa/Casablanca", "<+00>0<+01>,80/2,45/3"},
+		{"Europe/Berlin", "CET-1CEST,87/2,297/3"},
+		{"America/St_Johns", "NST3:30NDT,66/2,304/2"},
+		{"Asia/Tokyo", ""},
+	}
+
+	for _, tt := range tests {
+		loc, err := time.LoadLocation(tt.id)
+		if err != nil {
+			t.Skipf("system zoneinfo not available: %v", err)
+		}
+
+		got := yearRule(loc, 2026)
+		if got != tt.want {
+			t.Errorf("yearRule(%s, 2026) = %q, want %q", tt.id, got, tt.want)
+		}
+
+		if got == "" {
+			continue
+		}
+
+		// The rule reproduces zoneinfo throughout the year.
+		r, err := parseRule(got)
+		if err != nil {
+			t.Fatalf("yearRule(%s, 2026): %v", tt.id, err)
+		}
+
+		for instant := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC); instant.Year() == 2026; instant = instant.Add(time.Hour) {
+			if _, want := instant.In(loc).Zone(); r.offsetAt(instant.Unix()) != want {
+				t.Errorf("%s at %s: rule offset %d, zoneinfo %d", tt.id, instant.Format(time.RFC3339), r.offsetAt(instant.Unix()), want)
+
+				break
+			}
+		}
+	}
+}
+
+func TestLoadDirMissing(t *testing.T) {
+	t.Parallel()
+
+	if _, err := LoadDir(t.TempDir()); err == nil {
+		t.Error("LoadDir() on empty directory returned nil error")
+	}
+}
+
+// Examples.
+
+func ExampleRegistry_With() {
+	r, err := Embedded().With(ZoneData{Identifier: "Company/HQ", CountryCode: "NO", UtcOffset: 1})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, tz := range r.ByCountryCode("NO") {
+		fmt.Println(tz.Identifier())
+	}
+	// Output:
+	// Company/HQ
+	// Europe/Oslo
+}
@@ -0,0 +1,109 @@
+package tz
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// defaultRegistry backs the package-level functions. It is swapped atomically
+// so that concurrent readers always observe a fully built registry. A nil
+// value stands for the embedded registry.
+var defaultRegistry atomic.Pointer[Registry]
+
+// listener is a change listener registered with OnChange.
+type listener struct {
+	id int
+	fn func(old, current *Registry)
+}
+
+// Change listeners notified by SetDefault, in registration order.
+var (
+	listenersMu sync.Mutex
+	listeners   []listener
+	nextID      int
+)
+
+// swapMu serializes swaps so listeners observe changes in order.
+var swapMu sync.Mutex
+
+// Default returns the registry used by the package-level functions.
+func Default() *Registry {
+	if r := defaultRegistry.Load(); r != nil {
+		return r
+	}
+
+	return embedded
+}
+
+// SetDefault atomically replaces the registry used by the package-level
+// functions. All indices of r are built before it is published, so concurrent
+// readers never observe a partially built registry. Registered change
+// listeners are called synchronously after the swap, in registration order;
+// they must not call SetDefault or ReloadFile, which would deadlock. Passing
+// nil restores the embedded registry.
+func SetDefault(r *Registry) {
+	if r == nil {
+		r = embedded
+	}
+
+	r.warm()
+
+	swapMu.Lock()
+	defer swapMu.Unlock()
+
+	old := defaultRegistry.Swap(r)
+	if old == nil {
+		old = embedded
+	}
+
+	if old == r {
+		return
+	}
+
+	listenersMu.Lock()
+
+	// Copy so that listeners may register or cancel listeners.
+	fns := slices.Clone(listeners)
+
+	listenersMu.Unlock()
+
+	for _, l := range fns {
+		l.fn(old, r)
+	}
+}
+
+// ReloadFile reads a dataset file in any supported format (see LoadFile)
+// and makes it the default registry. On error the current default is left unchanged.
+func ReloadFile(path string) error {
+	r, err := LoadFile(path)
+	if err != nil {
+		return err
+	}
+
+	SetDefault(r)
+
+	return nil
+}
+
+// OnChange registers fn to be called after the default registry is replaced
+// by SetDefault or ReloadFile. Listeners are called in registration order
+// while the swap is in progress, so fn must not call SetDefault or
+// ReloadFile. The returned function unregisters fn.
+func OnChange(fn func(old, current *Registry)) func() {
+	listenersMu.Lock()
+	defer listenersMu.Unlock()
+
+	id := nextID
+	nextID++
+	listeners = append(listeners, listener{id: id, fn: fn})
+
+	return func() {
+		listenersMu.Lock()
+		defer listenersMu.Unlock()
+
+		listeners = slices.DeleteFunc(listeners, func(l listener) bool {
+			return l.id == id
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package tz
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+)
+
+//nolint:paralleltest // Mutates the default registry.
+func TestSetDefault(t *testing.T) {
+	t.Cleanup(func() { SetDefault(nil) })
+
+	r, err := Embedded().With(ZoneData{Identifier: "Company/HQ", CountryCode: "NO", UtcOffset: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var (
+		calls      int
+		gotOld     *Registry
+		gotCurrent *Registry
+	)
+
+	cancel := OnChange(func(old, current *Registry) {
+		calls++
+		gotOld, gotCurrent = old, current
+	})
+
+	SetDefault(r)
+
+	if Default() != r {
+		t.Fatal("Default() did not return the new registry")
+	}
+
+	if !IsValid("Company/HQ") {
+		t.Error("package-level IsValid() does not see the new registry")
+	}
+
+	if calls != 1 || gotOld != Embedded() || gotCurrent != r {
+		t.Errorf("OnChange callback: calls = %d, old = %p, current = %p", calls, gotOld, gotCurrent)
+	}
+
+	// Setting the same registry again is not a change.
+	SetDefault(r)
+
+	if calls != 1 {
+		t.Errorf("OnChange called %d times after no-op swap, want 1", calls)
+	}
+
+	cancel()
+	SetDefault(nil)
+
+	if calls != 1 {
+		t.Errorf("OnChange called %d times after cancel, want 1", calls)
+	}
+
+	if Default() != Embedded() {
+		t.Error("SetDefault(nil) did not restore the embedded registry")
+	}
+}
+
+//nolint:paralleltest // Mutates the default registry.
+func TestOnChangeOrder(t *testing.T) {
+	t.Cleanup(func() { SetDefault(nil) })
+
+	r, err := Embedded().With(ZoneData{Identifier: "Company/HQ", CountryCode: "NO", UtcOffset: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var order []int
+
+	for i := range 10 {
+		cancel := OnChange(func(_, _ *Registry) {
+			order = append(order, i)
+		})
+		t.Cleanup(cancel)
+	}
+
+	SetDefault(r)
+
+	if want := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !slices.Equal(order, want) {
+		t.Errorf("listeners called in order %v, want %v", order, want)
+	}
+}
+
+//nolint:paralleltest // Mutates the default registry.
+func TestReloadFile(t *testing.T) {
+	t.Cleanup(func() { SetDefault(nil) })
+
+	path := filepath.Join(t.TempDir(), "zones.txt")
+
+	if err := os.WriteFile(path, []byte("version test-1\nzone Company/HQ NO +1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ReloadFile(path); err != nil {
+		t.Fatalf("ReloadFile() error: %v", err)
+	}
+
+	if Default().Version() != "test-1" {
+		t.Errorf("Version() = %q, want %q", Default().Version(), "test-1")
+	}
+
+	if got := All(); len(got) != 1 || got[0] != "Company/HQ" {
+		t.Errorf("All() = %v, want [Company/HQ]", got)
+	}
+
+	// A malformed file leaves the current default in place.
+	if err := os.WriteFile(path, []byte("zone broken\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ReloadFile(path); err == nil {
+		t.Error("ReloadFile() on malformed file returned nil error")
+	}
+
+	if Default().Version() != "test-1" {
+		t.Errorf("Version() after failed reload = %q, want %q", Default().Version(), "test-1")
+	}
+}
+
+//nolint:paralleltest // Mutates the default registry.
+func TestConcurrentSetDefault(t *testing.T) {
+	t.Cleanup(func() { SetDefault(nil) })
+
+	alt, err := NewRegistry([]ZoneData{{Identifier: "Europe/Berlin", CountryCode: "DE", UtcOffset: 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := range 100 {
+		wg.Go(func() {
+			if i%10 == 0 {
+				if i%20 == 0 {
+					SetDefault(alt)
+				} else {
+					SetDefault(nil)
+				}
+
+				return
+			}
+
+			// Every registry contains Europe/Berlin, whichever is current.
+			if got := ByCountryCode("DE"); len(got) == 0 {
+				t.Error("ByCountryCode(\"DE\") returned empty during swap")
+			}
+		})
+	}
+
+	wg.Wait()
+}
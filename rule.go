@@ -0,0 +1,313 @@
+package tz
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// posixRule is a parsed POSIX TZ rule such as "CET-1CEST,M3.5.0,M10.5.0/3".
+// Offsets are in seconds east of UTC, the inverse of the POSIX sign convention.
+type posixRule struct {
+	stdName   string
+	stdOffset int
+	dstName   string
+	dstOffset int
+	start     ruleDate
+	end       ruleDate
+}
+
+// ruleDate is a transition date of a POSIX TZ rule.
+type ruleDate struct {
+	// kind is 'J' for Julian days 1-365 ignoring Feb 29, 'N' for zero-based
+	// days 0-365, or 'M' for month.week.weekday.
+	kind    byte
+	day     int
+	week    int
+	month   int
+	seconds int // Local wall-clock time of the transition, may exceed 24h.
+}
+
+// parseRule parses a POSIX TZ rule describing daylight saving time.
+// Rules without a DST part are rejected, as zones without DST carry no rule.
+func parseRule(s string) (posixRule, error) {
+	p := ruleParser{s: s}
+
+	var r posixRule
+
+	r.stdName = p.name()
+	r.stdOffset = -p.offset(24)
+	r.dstName = p.name()
+	r.dstOffset = r.stdOffset + 3600
+
+	if p.err == nil && r.stdName == "" {
+		p.fail("missing standard time name")
+	}
+
+	if p.err == nil && r.dstName == "" {
+		p.fail("missing daylight saving time")
+	}
+
+	if p.err == nil && p.peek() != ',' {
+		r.dstOffset = -p.offset(24)
+	}
+
+	p.expect(',')
+	r.start = p.date()
+	p.expect(',')
+	r.end = p.date()
+
+	if p.err == nil && p.s != "" {
+		p.fail("trailing characters")
+	}
+
+	if p.err != nil {
+		return posixRule{}, fmt.Errorf("rule %q: %w: %w", s, p.err, ErrInvalidZone)
+	}
+
+	return r, nil
+}
+
+// ruleParser consumes a POSIX TZ rule left to right, recording the first error.
+type ruleParser struct {
+	s   string
+	err error
+}
+
+func (p *ruleParser) fail(msg string) {
+	if p.err == nil {
+		p.err = fmt.Errorf("%s at %q", msg, p.s)
+	}
+}
+
+func (p *ruleParser) peek() byte {
+	if p.err != nil || p.s == "" {
+		return 0
+	}
+
+	return p.s[0]
+}
+
+func (p *ruleParser) expect(c byte) {
+	if p.peek() != c {
+		p.fail(fmt.Sprintf("expected %q", c))
+
+		return
+	}
+
+	p.s = p.s[1:]
+}
+
+// name parses an alphabetic name ("CET") or a quoted name ("<+0530>").
+// Returns an empty string if the input does not start with a name.
+func (p *ruleParser) name() string {
+	if p.err != nil {
+		return ""
+	}
+
+	if p.peek() == '<' {
+		end := strings.IndexByte(p.s, '>')
+		if end < 0 {
+			p.fail("unterminated name")
+
+			return ""
+		}
+
+		name := p.s[1:end]
+		p.s = p.s[end+1:]
+
+		return name
+	}
+
+	i := 0
+	for i < len(p.s) && (p.s[i] >= 'A' && p.s[i] <= 'Z' || p.s[i] >= 'a' && p.s[i] <= 'z') {
+		i++
+	}
+
+	if i > 0 && i < 3 {
+		p.fail("name too short")
+
+		return ""
+	}
+
+	name := p.s[:i]
+	p.s = p.s[i:]
+
+	return name
+}
+
+// offset parses "[+-]hh[:mm[:ss]]" with hours up to maxHours and returns
+// seconds in the POSIX sign convention.
+func (p *ruleParser) offset(maxHours int) int {
+	sign := 1
+
+	switch p.peek() {
+	case '-':
+		sign = -1
+		p.s = p.s[1:]
+	case '+':
+		p.s = p.s[1:]
+	}
+
+	seconds := p.number(0, maxHours) * 3600
+
+	if p.peek() == ':' {
+		p.s = p.s[1:]
+		seconds += p.number(0, 59) * 60
+
+		if p.peek() == ':' {
+			p.s = p.s[1:]
+			seconds += p.number(0, 59)
+		}
+	}
+
+	return sign * seconds
+}
+
+// number parses a decimal integer in [lo, hi].
+func (p *ruleParser) number(lo, hi int) int {
+	if p.err != nil {
+		return 0
+	}
+
+	i := 0
+	for i < len(p.s) && p.s[i] >= '0' && p.s[i] <= '9' {
+		i++
+	}
+
+	n, err := strconv.Atoi(p.s[:i])
+	if err != nil || n < lo || n > hi {
+		p.fail("number out of range")
+
+		return 0
+	}
+
+	p.s = p.s[i:]
+
+	return n
+}
+
+// date parses "Jn", "n" or "Mm.w.d", optionally followed by "/time".
+func (p *ruleParser) date() ruleDate {
+	var d ruleDate
+
+	switch p.peek() {
+	case 'J':
+		p.s = p.s[1:]
+		d.kind = 'J'
+		d.day = p.number(1, 365)
+	case 'M':
+		p.s = p.s[1:]
+		d.kind = 'M'
+		d.month = p.number(1, 12)
+		p.expect('.')
+		d.week = p.number(1, 5)
+		p.expect('.')
+		d.day = p.number(0, 6)
+	default:
+		d.kind = 'N'
+		d.day = p.number(0, 365)
+	}
+
+	d.seconds = 2 * 3600
+
+	if p.peek() == '/' {
+		p.s = p.s[1:]
+		// Transition times may range over [-167, 167] hours.
+		d.seconds = p.offset(167)
+	}
+
+	return d
+}
+
+// isDST reports whether daylight saving time is in effect at the Unix time sec.
+func (r *posixRule) isDST(sec int64) bool {
+	// Transitions are evaluated for the year of the instant in standard time.
+	year := time.Unix(sec+int64(r.stdOffset), 0).UTC().Year()
+
+	start, end := r.transitions(year)
+
+	if start < end {
+		return sec >= start && sec < end
+	}
+
+	// Southern hemisphere: DST spans the turn of the year.
+	return sec < end || sec >= start
+}
+
+// offsetAt returns the UTC offset in seconds in effect at the Unix time sec.
+func (r *posixRule) offsetAt(sec int64) int {
+	if r.isDST(sec) {
+		return r.dstOffset
+	}
+
+	return r.stdOffset
+}
+
+// transitions returns the Unix times at which DST starts and ends in year.
+// The start time is given in standard time and the end time in DST.
+func (r *posixRule) transitions(year int) (int64, int64) {
+	return r.start.unix(year) - int64(r.stdOffset), r.end.unix(year) - int64(r.dstOffset)
+}
+
+// unix returns the local wall-clock transition time in year as seconds since
+// the Unix epoch, as if the local time were UTC.
+func (d ruleDate) unix(year int) int64 {
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	var day time.Time
+
+	switch d.kind {
+	case 'J':
+		// Day 1-365, where Feb 29 is never counted.
+		day = jan1.AddDate(0, 0, d.day-1)
+		if isLeap(year) && d.day >= 60 {
+			day = day.AddDate(0, 0, 1)
+		}
+	case 'N':
+		day = jan1.AddDate(0, 0, d.day)
+	default:
+		// Weekday d of week w (5 meaning last) of month m.
+		first := time.Date(year, time.Month(d.month), 1, 0, 0, 0, 0, time.UTC)
+		offset := (d.day - int(first.Weekday()) + 7) % 7
+		day = first.AddDate(0, 0, offset+(d.week-1)*7)
+
+		for day.Month() != time.Month(d.month) {
+			day = day.AddDate(0, 0, -7)
+		}
+	}
+
+	return day.Unix() + int64(d.seconds)
+}
+
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// parsedRules caches parsed rules by their text, shared across registries.
+var parsedRules sync.Map
+
+// cachedRule returns the parsed form of a rule validated at registry
+// construction, or nil for an empty rule.
+func cachedRule(s string) *posixRule {
+	if s == "" {
+		return nil
+	}
+
+	if v, ok := parsedRules.Load(s); ok {
+		if r, ok := v.(*posixRule); ok {
+			return r
+		}
+	}
+
+	r, err := parseRule(s)
+	if err != nil {
+		return nil
+	}
+
+	parsedRules.Store(s, &r)
+
+	return &r
+}
@@ -0,0 +1,128 @@
+package tz
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule string
+		want posixRule
+	}{
+		{
+			rule: "CET-1CEST,M3.5.0,M10.5.0/3",
+			want: posixRule{
+				stdName: "CET", stdOffset: 3600, dstName: "CEST", dstOffset: 7200,
+				start: ruleDate{kind: 'M', month: 3, week: 5, day: 0, seconds: 7200},
+				end:   ruleDate{kind: 'M', month: 10, week: 5, day: 0, seconds: 10800},
+			},
+		},
+		{
+			rule: "NST3:30NDT,M3.2.0,M11.1.0",
+			want: posixRule{
+				stdName: "NST", stdOffset: -12600, dstName: "NDT", dstOffset: -9000,
+				start: ruleDate{kind: 'M', month: 3, week: 2, seconds: 7200},
+				end:   ruleDate{kind: 'M', month: 11, week: 1, seconds: 7200},
+			},
+		},
+		{
+			rule: "<+1030>-10:30<+11>-11,M10.1.0,M4.1.0",
+			want: posixRule{
+				stdName: "+1030", stdOffset: 37800, dstName: "+11", dstOffset: 39600,
+				start: ruleDate{kind: 'M', month: 10, week: 1, seconds: 7200},
+				end:   ruleDate{kind: 'M', month: 4, week: 1, seconds: 7200},
+			},
+		},
+		{
+			rule: "<-02>2<-01>,M3.5.0/-1,M10.5.0/0",
+			want: posixRule{
+				stdName: "-02", stdOffset: -7200, dstName: "-01", dstOffset: -3600,
+				start: ruleDate{kind: 'M', month: 3, week: 5, seconds: -3600},
+				end:   ruleDate{kind: 'M', month: 10, week: 5, seconds: 0},
+			},
+		},
+		{
+			rule: "EET-2EEST,M3.4.4/50,J300/1:30:15",
+			want: posixRule{
+				stdName: "EET", stdOffset: 7200, dstName: "EEST", dstOffset: 10800,
+				start: ruleDate{kind: 'M', month: 3, week: 4, day: 4, seconds: 50 * 3600},
+				end:   ruleDate{kind: 'J', day: 300, seconds: 5415},
+			},
+		},
+		{
+			rule: "XST5XDT,59,300",
+			want: posixRule{
+				stdName: "XST", stdOffset: -18000, dstName: "XDT", dstOffset: -14400,
+				start: ruleDate{kind: 'N', day: 59, seconds: 7200},
+				end:   ruleDate{kind: 'N', day: 300, seconds: 7200},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseRule(tt.rule)
+			if err != nil {
+				t.Fatalf("parseRule() error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("parseRule() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRuleInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, rule := range []string{
+		"",
+		"CET-1",
+		"<+01>-1",
+		"CET-1CEST",
+		"CET-1CEST,M3.5.0",
+		"CET-1CEST,M13.5.0,M10.5.0",
+		"CET-1CEST,M3.6.0,M10.5.0",
+		"CET-1CEST,M3.5.7,M10.5.0",
+		"CET-1CEST,J0,J100",
+		"CET-1CEST,M3.5.0,M10.5.0/168",
+		"CET-1CEST,M3.5.0,M10.5.0 ",
+		"CE-1CEST,M3.5.0,M10.5.0",
+		"<CET-1CEST,M3.5.0,M10.5.0",
+		"CET-25CEST,M3.5.0,M10.5.0",
+	} {
+		if _, err := parseRule(rule); !errors.Is(err, ErrInvalidZone) {
+			t.Errorf("parseRule(%q) error = %v, want ErrInvalidZone", rule, err)
+		}
+	}
+}
+
+func TestRulesIntegrity(t *testing.T) {
+	t.Parallel()
+
+	for id, rule := range rules {
+		data, ok := timezones[id]
+		if !ok {
+			t.Errorf("%s: rule for unknown timezone", id)
+
+			continue
+		}
+
+		r, err := parseRule(rule)
+		if err != nil {
+			t.Errorf("%s: %v", id, err)
+
+			continue
+		}
+
+		if got := float32(min(r.stdOffset, r.dstOffset)) / 3600; got != data.utcOffset {
+			t.Errorf("%s: rule lowest offset %v does not match UTC offset %v", id, got, data.utcOffset)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package tz
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// LogValue returns the timezone as a log group with its identifier, country
+// code and current UTC offset, e.g. id=Europe/Berlin country=DE offset=+02:00.
+// It implements slog.LogValuer.
+func (t Timezone) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", t.identifier),
+		slog.String("country", t.countryCode),
+		slog.String("offset", FormatOffset(t.OffsetAt(time.Now()))),
+	)
+}
+
+// LogHandler is a slog.Handler that renders record timestamps in a timezone
+// before passing records to another handler. Offsets are computed from the
+// package's own rules, so output does not depend on the host's zoneinfo.
+type LogHandler struct {
+	next slog.Handler
+	zone Timezone
+}
+
+// NewLogHandler returns a handler that renders record timestamps in zone and
+// forwards records to next.
+func NewLogHandler(next slog.Handler, zone Timezone) *LogHandler {
+	return &LogHandler{next: next, zone: zone}
+}
+
+// Enabled reports whether the wrapped handler handles records at level.
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle converts the record time to the handler's timezone and forwards the
+// record. Records with a zero time are forwarded unchanged.
+func (h *LogHandler) Handle(ctx context.Context, r slog.Record) error {
+	if !r.Time.IsZero() {
+		r.Time = h.zone.In(r.Time)
+	}
+
+	return h.next.Handle(ctx, r)
+}
+
+// WithAttrs returns a handler whose wrapped handler has the given attributes.
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &LogHandler{next: h.next.WithAttrs(attrs), zone: h.zone}
+}
+
+// WithGroup returns a handler whose wrapped handler opens the given group.
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	return &LogHandler{next: h.next.WithGroup(name), zone: h.zone}
+}
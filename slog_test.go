@@ -0,0 +1,79 @@
+package tz
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Compile-time interface checks.
+var (
+	_ slog.LogValuer = Timezone{}
+	_ slog.Handler   = (*LogHandler)(nil)
+)
+
+func TestTimezoneLogValue(t *testing.T) {
+	t.Parallel()
+
+	zone, err := Decode("Asia/Kathmandu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: dropTime}))
+	logger.Info("hello", "zone", zone)
+
+	if want := "level=INFO msg=hello zone.id=Asia/Kathmandu zone.country=NP zone.offset=+05:45\n"; buf.String() != want {
+		t.Errorf("log output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestLogHandler(t *testing.T) {
+	t.Parallel()
+
+	zone, err := Decode("America/New_York")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+
+	handler := NewLogHandler(slog.NewJSONHandler(&buf, nil), zone).WithAttrs([]slog.Attr{slog.String("app", "test")}).WithGroup("req")
+
+	record := slog.NewRecord(time.Date(2025, 7, 4, 16, 0, 0, 0, time.UTC), slog.LevelInfo, "hello", 0)
+	record.AddAttrs(slog.Int("id", 1))
+
+	if err := handler.Handle(t.Context(), record); err != nil {
+		t.Fatalf("Handle() error: %v", err)
+	}
+
+	if want := `{"time":"2025-07-04T12:00:00-04:00","level":"INFO","msg":"hello","app":"test","req":{"id":1}}`; strings.TrimSpace(buf.String()) != want {
+		t.Errorf("log output = %s, want %s", buf.String(), want)
+	}
+
+	if !handler.Enabled(t.Context(), slog.LevelInfo) || handler.Enabled(t.Context(), slog.LevelDebug) {
+		t.Error("Enabled() does not follow the wrapped handler")
+	}
+}
+
+func dropTime(_ []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey {
+		return slog.Attr{}
+	}
+
+	return a
+}
+
+// Examples.
+
+func ExampleNewLogHandler() {
+	zone, _ := Decode("Europe/Berlin")
+
+	logger := slog.New(NewLogHandler(slog.NewTextHandler(os.Stdout, nil), zone))
+	logger.Info("started")
+}
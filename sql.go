@@ -0,0 +1,69 @@
+package tz
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// errNullTimezone is returned when scanning SQL NULL into a Timezone.
+var errNullTimezone = errors.New("timezone: cannot scan NULL into Timezone, use NullTimezone")
+
+// Scan decodes a timezone identifier read from a database column through
+// Decode, resolving aliases. An empty string scans as the zero Timezone.
+// Returns ErrNotFound (wrapped) if the identifier is not recognized.
+// It implements sql.Scanner.
+func (t *Timezone) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	case nil:
+		return errNullTimezone
+	default:
+		return fmt.Errorf("timezone: cannot scan %T into Timezone", src)
+	}
+}
+
+// Value returns the timezone identifier for storage in a database column.
+// It implements driver.Valuer.
+func (t Timezone) Value() (driver.Value, error) {
+	return t.identifier, nil
+}
+
+// NullTimezone represents a Timezone that may be SQL NULL, in the manner of
+// sql.NullString.
+type NullTimezone struct {
+	Timezone Timezone
+	Valid    bool // Valid is true if Timezone is not NULL.
+}
+
+// Scan implements sql.Scanner. NULL scans as an invalid NullTimezone; any
+// other value is decoded like Timezone.Scan.
+func (n *NullTimezone) Scan(src any) error {
+	if src == nil {
+		*n = NullTimezone{}
+
+		return nil
+	}
+
+	if err := n.Timezone.Scan(src); err != nil {
+		n.Valid = false
+
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
+
+// Value implements driver.Valuer, returning nil for an invalid NullTimezone.
+func (n NullTimezone) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Timezone.Value()
+}
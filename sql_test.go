@@ -0,0 +1,113 @@
+package tz
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+// Compile-time interface checks.
+var (
+	_ sql.Scanner   = (*Timezone)(nil)
+	_ driver.Valuer = Timezone{}
+	_ sql.Scanner   = (*NullTimezone)(nil)
+	_ driver.Valuer = NullTimezone{}
+)
+
+func TestTimezoneScan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		src     any
+		want    string
+		wantErr error
+	}{
+		{name: "string", src: "Europe/Berlin", want: "Europe/Berlin"},
+		{name: "bytes", src: []byte("Asia/Tokyo"), want: "Asia/Tokyo"},
+		{name: "alias", src: "Asia/Calcutta", want: "Asia/Kolkata"},
+		{name: "empty", src: "", want: ""},
+		{name: "unknown", src: "Nowhere/Zone", wantErr: ErrNotFound},
+		{name: "null", src: nil, wantErr: errNullTimezone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tz Timezone
+
+			err := tz.Scan(tt.src)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Scan() error = %v, want %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Scan() error: %v", err)
+			}
+
+			if tz.Identifier() != tt.want {
+				t.Errorf("Identifier() = %q, want %q", tz.Identifier(), tt.want)
+			}
+		})
+	}
+
+	var tz Timezone
+
+	if err := tz.Scan(42); err == nil {
+		t.Error("Scan(42) returned nil error")
+	}
+}
+
+func TestTimezoneValue(t *testing.T) {
+	t.Parallel()
+
+	tz, err := Decode("Europe/Oslo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	v, err := tz.Value()
+	if err != nil || v != "Europe/Oslo" {
+		t.Errorf("Value() = %v, %v, want Europe/Oslo", v, err)
+	}
+}
+
+func TestNullTimezone(t *testing.T) {
+	t.Parallel()
+
+	var n NullTimezone
+
+	if err := n.Scan("Europe/Oslo"); err != nil {
+		t.Fatalf("Scan() error: %v", err)
+	}
+
+	if !n.Valid || n.Timezone.Identifier() != "Europe/Oslo" {
+		t.Errorf("Scan(\"Europe/Oslo\") = %+v", n)
+	}
+
+	if v, err := n.Value(); err != nil || v != "Europe/Oslo" {
+		t.Errorf("Value() = %v, %v, want Europe/Oslo", v, err)
+	}
+
+	if err := n.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) error: %v", err)
+	}
+
+	if n.Valid || n.Timezone != (Timezone{}) {
+		t.Errorf("Scan(nil) = %+v, want invalid", n)
+	}
+
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("Value() = %v, %v, want nil", v, err)
+	}
+
+	if err := n.Scan("Nowhere/Zone"); !errors.Is(err, ErrNotFound) || n.Valid {
+		t.Errorf("Scan(\"Nowhere/Zone\") error = %v, Valid = %v", err, n.Valid)
+	}
+}
@@ -1,37 +1,35 @@
 package tz
 
-import (
-	"errors"
-	"fmt"
-	"sort"
-	"sync"
-	"time"
-)
+import "errors"
 
 // ErrNotFound is returned when a timezone identifier is not in the dataset.
 var ErrNotFound = errors.New("timezone not found")
 
 // Timezone holds the decoded information for an IANA timezone identifier.
-// UTC offsets represent standard time only (DST adjustments are not reflected).
+// UtcOffset reports the standard offset; use OffsetAt for the offset in effect
+// at a given instant, including daylight saving time.
 type Timezone struct {
 	identifier  string
 	countryCode string
 	utcOffset   float32
+	rule        string
 }
 
-// Decode looks up a timezone by its IANA identifier.
+// Decode looks up a timezone by its IANA identifier. Alias identifiers such as
+// "US/Eastern" resolve to their canonical timezone; fixed zone identifiers
+// such as "UTC+05:45" resolve to the zone returned by FixedZone.
 // Returns ErrNotFound (wrapped) if the identifier is not recognized.
 func Decode(identifier string) (Timezone, error) {
-	data, ok := timezones[identifier]
-	if !ok {
-		return Timezone{}, fmt.Errorf("timezone %q: %w", identifier, ErrNotFound)
-	}
+	return Default().Decode(identifier)
+}
 
+func (r *Registry) timezone(identifier string, data tzData) Timezone {
 	return Timezone{
 		identifier:  identifier,
 		countryCode: data.countryCode,
 		utcOffset:   data.utcOffset,
-	}, nil
+		rule:        r.rules[identifier],
+	}
 }
 
 // Identifier returns the IANA timezone identifier.
@@ -56,94 +54,47 @@ func (t Timezone) CountryCodes() []string {
 	return []string{t.countryCode}
 }
 
-// UtcOffset returns the standard UTC offset in hours.
+// UtcOffset returns the standard UTC offset in hours, the lowest offset in
+// effect during the year. For Europe/Dublin that is GMT, +0.
 func (t Timezone) UtcOffset() float32 {
 	return t.utcOffset
 }
 
-// IsValid reports whether the given identifier is a recognized timezone.
-func IsValid(identifier string) bool {
-	_, ok := timezones[identifier]
-
-	return ok
+// Rule returns the POSIX TZ rule describing the timezone's daylight saving
+// time, e.g. "CET-1CEST,M3.5.0,M10.5.0/3", or "" if it does not observe DST.
+func (t Timezone) Rule() string {
+	return t.rule
 }
 
-// All returns a sorted slice of all supported IANA timezone identifiers.
-func All() []string {
-	result := make([]string, 0, len(timezones))
-
-	for id := range timezones {
-		result = append(result, id)
-	}
-
-	sort.Strings(result)
-
-	return result
-}
-
-// Lazy-built reverse lookup indices.
-var (
-	countryIndex     map[string][]Timezone
-	countryIndexOnce sync.Once
-
-	offsetIndex     map[float32][]Timezone
-	offsetIndexOnce sync.Once
-)
-
-func buildCountryIndex() {
-	countryIndex = make(map[string][]Timezone, len(timezones))
-
-	for id, data := range timezones {
-		tz := Timezone{identifier: id, countryCode: data.countryCode, utcOffset: data.utcOffset}
-		countryIndex[data.countryCode] = append(countryIndex[data.countryCode], tz)
-	}
-
-	// Sort each slice by identifier for deterministic output.
-	for code := range countryIndex {
-		slice := countryIndex[code]
-
-		sort.Slice(slice, func(i, j int) bool {
-			return slice[i].identifier < slice[j].identifier
-		})
-	}
+// IsValid reports whether the given identifier is a recognized timezone or
+// alias, or the identifier of a fixed zone.
+func IsValid(identifier string) bool {
+	return Default().IsValid(identifier)
 }
 
-func buildOffsetIndex() {
-	offsetIndex = make(map[float32][]Timezone, len(timezones))
-
-	for id, data := range timezones {
-		tz := Timezone{identifier: id, countryCode: data.countryCode, utcOffset: data.utcOffset}
-		offsetIndex[data.utcOffset] = append(offsetIndex[data.utcOffset], tz)
-	}
-
-	// Sort each slice by identifier for deterministic output.
-	for offset := range offsetIndex {
-		slice := offsetIndex[offset]
-
-		sort.Slice(slice, func(i, j int) bool {
-			return slice[i].identifier < slice[j].identifier
-		})
-	}
+// All returns a sorted slice of all supported canonical IANA timezone identifiers.
+func All() []string {
+	return Default().All()
 }
 
 // ByCountryCode returns all timezones for the given ISO 3166-1 alpha-2 country code.
 // Results are sorted by identifier. Returns nil if no timezones match.
 func ByCountryCode(code string) []Timezone {
-	countryIndexOnce.Do(buildCountryIndex)
-
-	return countryIndex[code]
+	return Default().ByCountryCode(code)
 }
 
 // ByUtcOffset returns all timezones with the given standard UTC offset in hours.
 // Results are sorted by identifier. Returns nil if no timezones match.
 func ByUtcOffset(offset float32) []Timezone {
-	offsetIndexOnce.Do(buildOffsetIndex)
-
-	return offsetIndex[offset]
+	return Default().ByUtcOffset(offset)
 }
 
-// Current returns the timezone for the system's current location.
-// Returns ErrNotFound if the system timezone is not in the dataset.
+// Current returns the system timezone, detected from $TZ, /etc/localtime and
+// other system configuration; see Detector.Detect. Use DetectCurrent to learn
+// which source was used.
+// Returns ErrNotFound (wrapped) if the system timezone is not in the dataset.
 func Current() (Timezone, error) {
-	return Decode(time.Now().Location().String())
+	detection, err := DetectCurrent()
+
+	return detection.Timezone, err
 }
@@ -23,9 +23,13 @@ func TestDecode(t *testing.T) {
 		{name: "Asia/Tokyo JST", identifier: "Asia/Tokyo", wantCode: "JP", wantOffset: 9},
 		{name: "Pacific/Auckland NZST", identifier: "Pacific/Auckland", wantCode: "NZ", wantOffset: 12},
 		{name: "Pacific/Kiritimati UTC+14", identifier: "Pacific/Kiritimati", wantCode: "KI", wantOffset: 14},
+		{name: "Antarctica/Macquarie AEST", identifier: "Antarctica/Macquarie", wantCode: "AU", wantOffset: 10},
+		{name: "Asia/Almaty since 2024", identifier: "Asia/Almaty", wantCode: "KZ", wantOffset: 5},
+		{name: "Asia/Qostanay since 2024", identifier: "Asia/Qostanay", wantCode: "KZ", wantOffset: 5},
 
 		// Negative offsets.
 		{name: "America/New_York EST", identifier: "America/New_York", wantCode: "US", wantOffset: -5},
+		{name: "America/Asuncion permanent -03", identifier: "America/Asuncion", wantCode: "PY", wantOffset: -3},
 		{name: "America/Los_Angeles PST", identifier: "America/Los_Angeles", wantCode: "US", wantOffset: -8},
 		{name: "Pacific/Pago_Pago SST", identifier: "Pacific/Pago_Pago", wantCode: "AS", wantOffset: -11},
 
@@ -215,6 +219,8 @@ func TestIsValid(t *testing.T) {
 		{"Asia/Tokyo", true},
 		{"Etc/UTC", true},
 		{"UTC", true},
+		{"US/Eastern", true},
+		{"Asia/Calcutta", true},
 		{"Invalid/Timezone", false},
 		{"", false},
 		{"europe/london", false},
@@ -231,6 +237,29 @@ func TestIsValid(t *testing.T) {
 	}
 }
 
+func TestDecodeResolvesAliases(t *testing.T) {
+	t.Parallel()
+
+	for alias, target := range links {
+		tz, err := Decode(alias)
+		if err != nil {
+			t.Errorf("Decode(%q): unexpected error: %v", alias, err)
+
+			continue
+		}
+
+		if tz.Identifier() != target {
+			t.Errorf("Decode(%q) = %q, want canonical %q", alias, tz.Identifier(), target)
+		}
+	}
+
+	for _, id := range All() {
+		if _, ok := links[id]; ok {
+			t.Errorf("All() contains alias %q", id)
+		}
+	}
+}
+
 func TestAll(t *testing.T) {
 	t.Parallel()
 
@@ -516,7 +545,7 @@ func ExampleAll() {
 	fmt.Printf("Total timezones: %d\n", len(all))
 	fmt.Printf("First: %s\n", all[0])
 	// Output:
-	// Total timezones: 418
+	// Total timezones: 443
 	// First: Africa/Abidjan
 }
 
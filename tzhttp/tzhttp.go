@@ -0,0 +1,181 @@
+// Package tzhttp provides net/http middleware that resolves the timezone of
+// a request and stores it in the request context.
+//
+//	handler := tzhttp.Middleware()(mux)
+//
+//	func serve(w http.ResponseWriter, r *http.Request) {
+//		zone, ok := tzhttp.FromContext(r.Context())
+//		...
+//	}
+package tzhttp
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/infobits-io/tz"
+)
+
+// Resolver extracts a timezone from a request. It reports false if the
+// request carries no valid timezone.
+type Resolver func(r *http.Request) (tz.Timezone, bool)
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying zone.
+func NewContext(ctx context.Context, zone tz.Timezone) context.Context {
+	return context.WithValue(ctx, contextKey{}, zone)
+}
+
+// FromContext returns the timezone stored by Middleware or NewContext.
+// Reports false if the context carries no timezone.
+func FromContext(ctx context.Context) (tz.Timezone, bool) {
+	zone, ok := ctx.Value(contextKey{}).(tz.Timezone)
+
+	return zone, ok
+}
+
+// DefaultResolvers is the chain used by Middleware when none is given: the
+// "tz" query parameter, the "tz" cookie, the Sec-CH-Time-Zone and X-Timezone
+// headers, and finally the Accept-Language region.
+func DefaultResolvers() []Resolver {
+	return []Resolver{
+		Query("tz"),
+		Cookie("tz"),
+		Header("Sec-CH-Time-Zone", "X-Timezone"),
+		AcceptLanguage(),
+	}
+}
+
+// Middleware returns middleware that tries the resolvers in order and stores
+// the first timezone found in the request context. Without resolvers it uses
+// DefaultResolvers. Requests for which no resolver succeeds are passed on
+// unchanged.
+func Middleware(resolvers ...Resolver) func(http.Handler) http.Handler {
+	if len(resolvers) == 0 {
+		resolvers = DefaultResolvers()
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, resolve := range resolvers {
+				if zone, ok := resolve(r); ok {
+					r = r.WithContext(NewContext(r.Context(), zone))
+
+					break
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// decode validates an identifier through tz.Decode, resolving aliases.
+func decode(id string) (tz.Timezone, bool) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return tz.Timezone{}, false
+	}
+
+	zone, err := tz.Decode(id)
+
+	return zone, err == nil
+}
+
+// Query resolves the timezone from the named URL query parameter.
+func Query(name string) Resolver {
+	return func(r *http.Request) (tz.Timezone, bool) {
+		return decode(r.URL.Query().Get(name))
+	}
+}
+
+// Cookie resolves the timezone from the named cookie.
+func Cookie(name string) Resolver {
+	return func(r *http.Request) (tz.Timezone, bool) {
+		cookie, err := r.Cookie(name)
+		if err != nil {
+			return tz.Timezone{}, false
+		}
+
+		return decode(cookie.Value)
+	}
+}
+
+// Header resolves the timezone from the first of the named headers holding a
+// valid identifier, such as a header set from
+// Intl.DateTimeFormat().resolvedOptions().timeZone by client scripts.
+// Surrounding quotes, as used by structured client hints, are removed.
+func Header(names ...string) Resolver {
+	return func(r *http.Request) (tz.Timezone, bool) {
+		for _, name := range names {
+			if zone, ok := decode(strings.Trim(r.Header.Get(name), `"`)); ok {
+				return zone, true
+			}
+		}
+
+		return tz.Timezone{}, false
+	}
+}
+
+// AcceptLanguage resolves the timezone from the region subtags of the
+// Accept-Language header, in order of preference, via tz.ByCountryCode.
+// Only countries with a single timezone resolve, since the language alone
+// cannot tell, say, New York from Los Angeles.
+func AcceptLanguage() Resolver {
+	return func(r *http.Request) (tz.Timezone, bool) {
+		for _, region := range languageRegions(r.Header.Get("Accept-Language")) {
+			if zones := tz.ByCountryCode(region); len(zones) == 1 {
+				return zones[0], true
+			}
+		}
+
+		return tz.Timezone{}, false
+	}
+}
+
+// languageRegions returns the upper-cased region subtags of an
+// Accept-Language header, ordered by descending quality.
+func languageRegions(header string) []string {
+	type entry struct {
+		region  string
+		quality float64
+	}
+
+	var entries []entry
+
+	for part := range strings.SplitSeq(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = v
+			}
+		}
+
+		subtags := strings.Split(tag, "-")
+		for _, subtag := range subtags[1:] {
+			if len(subtag) == 2 && quality > 0 {
+				entries = append(entries, entry{region: strings.ToUpper(subtag), quality: quality})
+
+				break
+			}
+		}
+	}
+
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		return cmp.Compare(b.quality, a.quality)
+	})
+
+	regions := make([]string, len(entries))
+	for i, e := range entries {
+		regions[i] = e.region
+	}
+
+	return regions
+}
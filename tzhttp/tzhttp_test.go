@@ -0,0 +1,96 @@
+package tzhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/infobits-io/tz"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		target string
+		header http.Header
+		want   string
+	}{
+		{name: "query", target: "/?tz=Asia/Tokyo", header: http.Header{"Cookie": {"tz=Europe/Oslo"}}, want: "Asia/Tokyo"},
+		{name: "query alias", target: "/?tz=US/Pacific", want: "America/Los_Angeles"},
+		{name: "invalid query falls through", target: "/?tz=Nowhere", header: http.Header{"Cookie": {"tz=Europe/Oslo"}}, want: "Europe/Oslo"},
+		{name: "cookie", header: http.Header{"Cookie": {"tz=Europe/Oslo"}, "X-Timezone": {"Asia/Tokyo"}}, want: "Europe/Oslo"},
+		{name: "client hint", header: http.Header{"Sec-Ch-Time-Zone": {`"America/Chicago"`}}, want: "America/Chicago"},
+		{name: "custom header", header: http.Header{"X-Timezone": {"Australia/Perth"}}, want: "Australia/Perth"},
+		{name: "accept language", header: http.Header{"Accept-Language": {"en-US;q=0.5, nb-NO, en;q=0.8"}}, want: "Europe/Oslo"},
+		{name: "ambiguous accept language", header: http.Header{"Accept-Language": {"en-US"}}},
+		{name: "nothing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			target := tt.target
+			if target == "" {
+				target = "/"
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, target, nil)
+			for key, values := range tt.header {
+				req.Header[key] = values
+			}
+
+			var (
+				got tz.Timezone
+				ok  bool
+			)
+
+			handler := Middleware()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got, ok = FromContext(r.Context())
+			}))
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if ok != (tt.want != "") || got.Identifier() != tt.want {
+				t.Errorf("FromContext() = %q, %v, want %q", got.Identifier(), ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestMiddlewareCustomChain(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?zone=Asia/Tokyo&tz=Europe/Oslo", nil)
+
+	var got tz.Timezone
+
+	Middleware(Query("zone"))(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got, _ = FromContext(r.Context())
+	})).ServeHTTP(httptest.NewRecorder(), req)
+
+	if got.Identifier() != "Asia/Tokyo" {
+		t.Errorf("FromContext() = %q, want Asia/Tokyo", got.Identifier())
+	}
+}
+
+func TestLanguageRegions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string][]string{
+		"":                               {},
+		"de":                             {},
+		"pt-BR":                          {"BR"},
+		"en-US;q=0.5, de-DE, fr;q=0.9":   {"DE", "US"},
+		"zh-Hant-TW, en-GB;q=0, es-419":  {"TW"},
+		"nb-no;q=0.7,sv-SE;q=0.7,en;q=1": {"NO", "SE"},
+	}
+
+	for header, want := range tests {
+		if got := languageRegions(header); !slices.Equal(got, want) {
+			t.Errorf("languageRegions(%q) = %v, want %v", header, got, want)
+		}
+	}
+}
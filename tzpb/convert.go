@@ -0,0 +1,80 @@
+// Package tzpb provides protocol buffer messages for timezones, generated
+// from tz.proto, and conversions to and from tz.Timezone and time.Time.
+//
+// It is a separate module so that the root package stays free of
+// dependencies.
+package tzpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative tz.proto
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/infobits-io/tz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrMissing is returned when a required message or field is unset.
+var ErrMissing = errors.New("missing field")
+
+// NewTimezone returns the message for a timezone.
+func NewTimezone(zone tz.Timezone) *Timezone {
+	return &Timezone{Id: zone.Identifier()}
+}
+
+// ToTimezone validates the message through tz.Decode, resolving aliases.
+// Returns tz.ErrNotFound (wrapped) for unknown identifiers and ErrMissing
+// (wrapped) for a nil message or empty identifier.
+func ToTimezone(m *Timezone) (tz.Timezone, error) {
+	if m.GetId() == "" {
+		return tz.Timezone{}, fmt.Errorf("timezone id: %w", ErrMissing)
+	}
+
+	return tz.Decode(m.GetId())
+}
+
+// NewOffset returns the message for a UTC offset in hours, rounded to the
+// second.
+func NewOffset(hours float32) *Offset {
+	return &Offset{Seconds: int32(math.Round(float64(hours) * 3600))}
+}
+
+// OffsetHours returns the offset of the message in hours, or 0 for nil.
+func OffsetHours(m *Offset) float32 {
+	return float32(m.GetSeconds()) / 3600
+}
+
+// NewZonedTimestamp returns the message for instant t in zone, including the
+// offset in effect at t.
+func NewZonedTimestamp(t time.Time, zone tz.Timezone) *ZonedTimestamp {
+	return &ZonedTimestamp{
+		Time:   timestamppb.New(t),
+		Zone:   NewTimezone(zone),
+		Offset: NewOffset(zone.OffsetAt(t)),
+	}
+}
+
+// ToTime returns the instant of the message in its timezone's local time,
+// together with the validated timezone. The offset field is ignored; the
+// offset is derived from the timezone's rules.
+// Returns ErrMissing (wrapped) if the time or zone is unset, and
+// tz.ErrNotFound (wrapped) for an unknown timezone.
+func ToTime(m *ZonedTimestamp) (time.Time, tz.Timezone, error) {
+	if m.GetTime() == nil {
+		return time.Time{}, tz.Timezone{}, fmt.Errorf("zoned timestamp time: %w", ErrMissing)
+	}
+
+	if err := m.GetTime().CheckValid(); err != nil {
+		return time.Time{}, tz.Timezone{}, fmt.Errorf("zoned timestamp time: %w", err)
+	}
+
+	zone, err := ToTimezone(m.GetZone())
+	if err != nil {
+		return time.Time{}, tz.Timezone{}, err
+	}
+
+	return zone.In(m.GetTime().AsTime()), zone, nil
+}
@@ -0,0 +1,113 @@
+package tzpb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/infobits-io/tz"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTimezoneRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := tz.Decode("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := proto.Marshal(NewTimezone(berlin))
+	if err != nil {
+		t.Fatalf("proto.Marshal() error: %v", err)
+	}
+
+	var m Timezone
+
+	if err := proto.Unmarshal(data, &m); err != nil {
+		t.Fatalf("proto.Unmarshal() error: %v", err)
+	}
+
+	if got, err := ToTimezone(&m); err != nil || got != berlin {
+		t.Errorf("ToTimezone() = %v, %v, want %v", got, err, berlin)
+	}
+}
+
+func TestToTimezoneErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg     *Timezone
+		wantErr error
+	}{
+		{nil, ErrMissing},
+		{&Timezone{}, ErrMissing},
+		{&Timezone{Id: "Mars/Olympus_Mons"}, tz.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		if _, err := ToTimezone(tt.msg); !errors.Is(err, tt.wantErr) {
+			t.Errorf("ToTimezone(%v) error = %v, want %v", tt.msg, err, tt.wantErr)
+		}
+	}
+
+	if got, err := ToTimezone(&Timezone{Id: "US/Eastern"}); err != nil || got.Identifier() != "America/New_York" {
+		t.Errorf("ToTimezone(US/Eastern) = %v, %v", got, err)
+	}
+}
+
+func TestOffset(t *testing.T) {
+	t.Parallel()
+
+	for _, hours := range []float32{0, 5.5, 5.75, -3.5, 14} {
+		if got := OffsetHours(NewOffset(hours)); got != hours {
+			t.Errorf("OffsetHours(NewOffset(%v)) = %v", hours, got)
+		}
+	}
+
+	if got := NewOffset(5.5).GetSeconds(); got != 19800 {
+		t.Errorf("NewOffset(5.5) seconds = %d, want 19800", got)
+	}
+
+	// Whole-minute offsets that float32 cannot represent exactly.
+	for minutes := int32(-12 * 60); minutes <= 14*60; minutes++ {
+		if got := NewOffset(float32(minutes) / 60).GetSeconds(); got != minutes*60 {
+			t.Errorf("NewOffset(%d minutes) seconds = %d, want %d", minutes, got, minutes*60)
+		}
+	}
+}
+
+func TestZonedTimestamp(t *testing.T) {
+	t.Parallel()
+
+	zone, err := tz.Decode("America/New_York")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	instant := time.Date(2026, 7, 4, 16, 0, 0, 0, time.UTC)
+	m := NewZonedTimestamp(instant, zone)
+
+	if got := m.GetOffset().GetSeconds(); got != -4*3600 {
+		t.Errorf("offset = %d, want %d", got, -4*3600)
+	}
+
+	got, gotZone, err := ToTime(m)
+	if err != nil {
+		t.Fatalf("ToTime() error: %v", err)
+	}
+
+	if !got.Equal(instant) || gotZone != zone || got.Format("15:04 MST") != "12:00 EDT" {
+		t.Errorf("ToTime() = %v, %v", got, gotZone)
+	}
+
+	if _, _, err := ToTime(&ZonedTimestamp{Zone: m.GetZone()}); !errors.Is(err, ErrMissing) {
+		t.Errorf("ToTime() without time error = %v, want ErrMissing", err)
+	}
+
+	invalid := &ZonedTimestamp{Time: &timestamppb.Timestamp{Nanos: -1}, Zone: m.GetZone()}
+	if _, _, err := ToTime(invalid); err == nil {
+		t.Error("ToTime() with invalid timestamp returned nil error")
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/infobits-io/tz/tzpb
+
+go 1.26
+
+// Until the root module publishes a tagged release, tzpb builds against the
+// working tree through the repository's go.work.
+require (
+	github.com/infobits-io/tz v0.0.0-00010101000000-000000000000
+	google.golang.org/protobuf v1.36.9
+)
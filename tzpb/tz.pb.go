@@ -0,0 +1,253 @@
+// Protocol buffer messages for timezones, anchored on the
+// github.com/infobits-io/tz dataset. Identifiers are IANA timezone
+// identifiers; receivers should validate them, e.g. with tzpb.ToTimezone.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: tz.proto
+
+package tzpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Timezone identifies an IANA timezone.
+type Timezone struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Canonical IANA identifier, e.g. "Europe/Berlin". Senders should use
+	// canonical identifiers; receivers also accept aliases such as "US/Eastern".
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Timezone) Reset() {
+	*x = Timezone{}
+	mi := &file_tz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Timezone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timezone) ProtoMessage() {}
+
+func (x *Timezone) ProtoReflect() protoreflect.Message {
+	mi := &file_tz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timezone.ProtoReflect.Descriptor instead.
+func (*Timezone) Descriptor() ([]byte, []int) {
+	return file_tz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Timezone) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Offset is a UTC offset.
+type Offset struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Offset in seconds east of UTC, e.g. 19800 for UTC+05:30.
+	Seconds       int32 `protobuf:"varint,1,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Offset) Reset() {
+	*x = Offset{}
+	mi := &file_tz_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Offset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offset) ProtoMessage() {}
+
+func (x *Offset) ProtoReflect() protoreflect.Message {
+	mi := &file_tz_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offset.ProtoReflect.Descriptor instead.
+func (*Offset) Descriptor() ([]byte, []int) {
+	return file_tz_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Offset) GetSeconds() int32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+// ZonedTimestamp is an instant together with the timezone it belongs to.
+type ZonedTimestamp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The instant.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The timezone in which the instant is displayed.
+	Zone *Timezone `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	// The UTC offset in effect in zone at time. Informational; receivers
+	// derive the offset from zone and time.
+	Offset        *Offset `protobuf:"bytes,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZonedTimestamp) Reset() {
+	*x = ZonedTimestamp{}
+	mi := &file_tz_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZonedTimestamp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZonedTimestamp) ProtoMessage() {}
+
+func (x *ZonedTimestamp) ProtoReflect() protoreflect.Message {
+	mi := &file_tz_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZonedTimestamp.ProtoReflect.Descriptor instead.
+func (*ZonedTimestamp) Descriptor() ([]byte, []int) {
+	return file_tz_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ZonedTimestamp) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ZonedTimestamp) GetZone() *Timezone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+func (x *ZonedTimestamp) GetOffset() *Offset {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+var File_tz_proto protoreflect.FileDescriptor
+
+const file_tz_proto_rawDesc = "" +
+	"\n" +
+	"\btz.proto\x12\x0einfobits.tz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1a\n" +
+	"\bTimezone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\"\n" +
+	"\x06Offset\x12\x18\n" +
+	"\aseconds\x18\x01 \x01(\x05R\aseconds\"\x9e\x01\n" +
+	"\x0eZonedTimestamp\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12,\n" +
+	"\x04zone\x18\x02 \x01(\v2\x18.infobits.tz.v1.TimezoneR\x04zone\x12.\n" +
+	"\x06offset\x18\x03 \x01(\v2\x16.infobits.tz.v1.OffsetR\x06offsetB Z\x1egithub.com/infobits-io/tz/tzpbb\x06proto3"
+
+var (
+	file_tz_proto_rawDescOnce sync.Once
+	file_tz_proto_rawDescData []byte
+)
+
+func file_tz_proto_rawDescGZIP() []byte {
+	file_tz_proto_rawDescOnce.Do(func() {
+		file_tz_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tz_proto_rawDesc), len(file_tz_proto_rawDesc)))
+	})
+	return file_tz_proto_rawDescData
+}
+
+var file_tz_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_tz_proto_goTypes = []any{
+	(*Timezone)(nil),              // 0: infobits.tz.v1.Timezone
+	(*Offset)(nil),                // 1: infobits.tz.v1.Offset
+	(*ZonedTimestamp)(nil),        // 2: infobits.tz.v1.ZonedTimestamp
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_tz_proto_depIdxs = []int32{
+	3, // 0: infobits.tz.v1.ZonedTimestamp.time:type_name -> google.protobuf.Timestamp
+	0, // 1: infobits.tz.v1.ZonedTimestamp.zone:type_name -> infobits.tz.v1.Timezone
+	1, // 2: infobits.tz.v1.ZonedTimestamp.offset:type_name -> infobits.tz.v1.Offset
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_tz_proto_init() }
+func file_tz_proto_init() {
+	if File_tz_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tz_proto_rawDesc), len(file_tz_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tz_proto_goTypes,
+		DependencyIndexes: file_tz_proto_depIdxs,
+		MessageInfos:      file_tz_proto_msgTypes,
+	}.Build()
+	File_tz_proto = out.File
+	file_tz_proto_goTypes = nil
+	file_tz_proto_depIdxs = nil
+}
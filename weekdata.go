@@ -0,0 +1,124 @@
+package tz
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// WeekData describes the conventional week of a country, from the CLDR
+// supplemental weekData.
+type WeekData struct {
+	// FirstDay is the first day of the week in calendars.
+	FirstDay time.Weekday
+	// WeekendStart and WeekendEnd are the first and last days of the
+	// weekend. They are equal for one-day weekends.
+	WeekendStart time.Weekday
+	WeekendEnd   time.Weekday
+	// MinDays is the minimal number of days in the first week of a year,
+	// e.g. 4 for ISO 8601 weeks.
+	MinDays int
+}
+
+// IsWeekend reports whether day falls within the weekend.
+func (w WeekData) IsWeekend(day time.Weekday) bool {
+	if w.WeekendStart <= w.WeekendEnd {
+		return day >= w.WeekendStart && day <= w.WeekendEnd
+	}
+
+	// The weekend wraps around the end of time.Weekday, e.g. Saturday to Sunday.
+	return day >= w.WeekendStart || day <= w.WeekendEnd
+}
+
+// defaultWeekData is the CLDR world default (territory 001).
+var defaultWeekData = WeekData{
+	FirstDay:     time.Monday,
+	WeekendStart: time.Saturday,
+	WeekendEnd:   time.Sunday,
+	MinDays:      1,
+}
+
+// CLDR weekData exceptions to defaultWeekData, as space-separated territory lists.
+var (
+	firstDayTerritories = map[time.Weekday]string{
+		time.Friday:   "MV",
+		time.Saturday: "AE AF BH DJ DZ EG IQ IR JO KW LY OM QA SD SY",
+		time.Sunday: "AG AS BD BR BS BT BW BZ CA CN CO DM DO ET GT GU HK HN ID IL IN JM JP KE KH KR LA MH MM MO MT MX MZ NI NP PA PE PH PK PR PT PY SA " +
+			"SG SV TH TT TW UM US VE VI WS YE ZA ZW",
+	}
+	weekendStartTerritories = map[time.Weekday]string{
+		time.Thursday: "AF",
+		time.Friday:   "BH DZ EG IL IQ IR JO KW LY OM QA SA SD SY YE",
+		time.Sunday:   "IN UG",
+	}
+	weekendEndTerritories = map[time.Weekday]string{
+		time.Friday:   "AF IR",
+		time.Saturday: "BH DZ EG IL IQ JO KW LY OM QA SA SD SY YE",
+	}
+	// minDaysISOTerritories use 4 minimal days in the first week, as in ISO 8601.
+	minDaysISOTerritories = "AD AT AX BE BG CH CZ DE DK EE ES FI FJ FO FR GB GF GG GI GP GR HU IE IM IS IT JE LI LT LU MC MQ NL NO PL PT RE RU SE SJ SK SM VA"
+)
+
+// weekDataIndex maps territories to their week data, built on first use
+// from the CLDR exception lists.
+var weekDataIndex = sync.OnceValue(func() map[string]WeekData {
+	index := make(map[string]WeekData)
+
+	set := func(lists map[time.Weekday]string, apply func(*WeekData, time.Weekday)) {
+		for day, territories := range lists {
+			for code := range strings.FieldsSeq(territories) {
+				w, ok := index[code]
+				if !ok {
+					w = defaultWeekData
+				}
+
+				apply(&w, day)
+				index[code] = w
+			}
+		}
+	}
+
+	set(firstDayTerritories, func(w *WeekData, day time.Weekday) { w.FirstDay = day })
+	set(weekendStartTerritories, func(w *WeekData, day time.Weekday) { w.WeekendStart = day })
+	set(weekendEndTerritories, func(w *WeekData, day time.Weekday) { w.WeekendEnd = day })
+
+	for code := range strings.FieldsSeq(minDaysISOTerritories) {
+		w, ok := index[code]
+		if !ok {
+			w = defaultWeekData
+		}
+
+		w.MinDays = 4
+		index[code] = w
+	}
+
+	return index
+})
+
+// weekDataFor returns the week data for an ISO 3166-1 alpha-2 code, falling
+// back to the world default.
+func weekDataFor(code string) WeekData {
+	if w, ok := weekDataIndex()[code]; ok {
+		return w
+	}
+
+	return defaultWeekData
+}
+
+// WeekData returns the country's conventional week.
+func (c Country) WeekData() WeekData {
+	return weekDataFor(c.alpha2)
+}
+
+// WeekData returns the conventional week of the timezone's country, or the
+// world default (Monday first, Saturday–Sunday weekend) for zones without
+// a country.
+func (t Timezone) WeekData() WeekData {
+	return weekDataFor(t.countryCode)
+}
+
+// IsWeekend reports whether the instant falls on a weekend day in the
+// timezone's local time, according to its country's week.
+func (t Timezone) IsWeekend(instant time.Time) bool {
+	return t.WeekData().IsWeekend(t.In(instant).Weekday())
+}
@@ -0,0 +1,83 @@
+package tz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekData(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code    string
+		want    WeekData
+		weekend []time.Weekday
+	}{
+		{"DE", WeekData{time.Monday, time.Saturday, time.Sunday, 4}, []time.Weekday{time.Saturday, time.Sunday}},
+		{"US", WeekData{time.Sunday, time.Saturday, time.Sunday, 1}, []time.Weekday{time.Saturday, time.Sunday}},
+		{"SA", WeekData{time.Sunday, time.Friday, time.Saturday, 1}, []time.Weekday{time.Friday, time.Saturday}},
+		{"IR", WeekData{time.Saturday, time.Friday, time.Friday, 1}, []time.Weekday{time.Friday}},
+		{"AF", WeekData{time.Saturday, time.Thursday, time.Friday, 1}, []time.Weekday{time.Thursday, time.Friday}},
+		{"IN", WeekData{time.Sunday, time.Sunday, time.Sunday, 1}, []time.Weekday{time.Sunday}},
+		{"MV", WeekData{time.Friday, time.Saturday, time.Sunday, 1}, []time.Weekday{time.Saturday, time.Sunday}},
+		{"PT", WeekData{time.Sunday, time.Saturday, time.Sunday, 4}, []time.Weekday{time.Saturday, time.Sunday}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			t.Parallel()
+
+			country, err := DecodeCountry(tt.code)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := country.WeekData()
+			if got != tt.want {
+				t.Errorf("WeekData() = %+v, want %+v", got, tt.want)
+			}
+
+			for day := time.Sunday; day <= time.Saturday; day++ {
+				want := false
+
+				for _, w := range tt.weekend {
+					want = want || w == day
+				}
+
+				if got.IsWeekend(day) != want {
+					t.Errorf("IsWeekend(%s) = %v, want %v", day, !want, want)
+				}
+			}
+		})
+	}
+}
+
+func TestTimezoneIsWeekend(t *testing.T) {
+	t.Parallel()
+
+	// Friday 22:00 UTC is Friday evening in New York but Saturday in Tokyo.
+	instant := time.Date(2026, 7, 3, 22, 0, 0, 0, time.UTC)
+
+	tests := map[string]bool{
+		"America/New_York": false,
+		"Asia/Tokyo":       true,
+		"Asia/Riyadh":      true,
+		"Asia/Tehran":      false,
+		"Etc/UTC":          false,
+	}
+
+	for id, want := range tests {
+		zone, err := Decode(id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := zone.IsWeekend(instant); got != want {
+			t.Errorf("%s: IsWeekend() = %v, want %v", id, got, want)
+		}
+	}
+
+	if got := (Timezone{}).WeekData(); got != defaultWeekData {
+		t.Errorf("WeekData() without country = %+v, want default", got)
+	}
+}